@@ -84,20 +84,23 @@ function toggleSelected(classList) {
 
 function distributeSeats() {
     var radius = 125;
-    var seats = document.getElementsByClassName("seat")
-    var container = document.getElementById("draftContainer")
-    var containerStyles = window.getComputedStyle(container)
-    var width = containerStyles.width.replace("px", "")
-    var height = containerStyles.height.replace("px", "")
-    var angle = 1.5 * Math.PI
-    var step = (2 * Math.PI) / seats.length;
-    for (let i = 0; i < seats.length; i++) {
-        let seatWidth = window.getComputedStyle(seats[i]).width.replace("px", "")
-        let seatHeight = window.getComputedStyle(seats[i]).height.replace("px", "")
-        var x = Math.round(width / 2 + radius * Math.cos(angle) - seatWidth / 2);
-        var y = Math.round(height / 2 + radius * Math.sin(angle) - seatHeight / 2);
-        seats[i].style.left = x + "px"
-        seats[i].style.top = y + "px"
-        angle += step;
+    var containers = document.getElementsByClassName("draftContainer")
+    for (let c = 0; c < containers.length; c++) {
+        var container = containers[c]
+        var seats = container.getElementsByClassName("seat")
+        var containerStyles = window.getComputedStyle(container)
+        var width = containerStyles.width.replace("px", "")
+        var height = containerStyles.height.replace("px", "")
+        var angle = 1.5 * Math.PI
+        var step = (2 * Math.PI) / seats.length;
+        for (let i = 0; i < seats.length; i++) {
+            let seatWidth = window.getComputedStyle(seats[i]).width.replace("px", "")
+            let seatHeight = window.getComputedStyle(seats[i]).height.replace("px", "")
+            var x = Math.round(width / 2 + radius * Math.cos(angle) - seatWidth / 2);
+            var y = Math.round(height / 2 + radius * Math.sin(angle) - seatHeight / 2);
+            seats[i].style.left = x + "px"
+            seats[i].style.top = y + "px"
+            angle += step;
+        }
     }
 }
\ No newline at end of file
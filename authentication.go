@@ -28,7 +28,7 @@ type Claims struct {
 }
 
 func (s *Server) handleGETSignUp(w http.ResponseWriter, r *http.Request) {
-	err = templ.ExecuteTemplate(w, "signUp.html", nil)
+	err = templ.ExecuteTemplate(w, "signUp.html", r.URL.Query())
 	if err != nil {
 		handleError(w, http.StatusInternalServerError, err, true)
 	}
@@ -57,18 +57,35 @@ func (s *Server) handleSignUp(w http.ResponseWriter, r *http.Request) {
 		handleError(w, http.StatusInternalServerError, err, false)
 		return
 	}
-	plr := NewPlayer(s)
-	ID := PlayerID(uuid.MakeV4())
-	tID := TrackerID(uuid.MakeV4())
-	err = plr.Create(ID, tID, "player", creds.Mail, string(hashedPassword))
-	if err != nil {
-		handleError(w, http.StatusInternalServerError, err, false)
-		return
+	var plr *Player
+	if guest := PlayerID(r.FormValue("guest")); guest != "" {
+		// signing up with a claim code turns the guest into this account
+		plr, err = LoadPlayer(s, guest)
+		if err != nil {
+			handleError(w, http.StatusInternalServerError, err, false)
+			return
+		}
+		err = plr.Claim(r.FormValue("code"), creds.Mail, string(hashedPassword))
+		if err != nil {
+			handleError(w, http.StatusForbidden, err, false)
+			return
+		}
+	} else {
+		plr = NewPlayer(s)
+		ID := PlayerID(uuid.MakeV4())
+		tID := TrackerID(uuid.MakeV4())
+		err = plr.Create(ID, tID, "player", creds.Mail, string(hashedPassword))
+		if err != nil {
+			handleError(w, http.StatusInternalServerError, err, false)
+			return
+		}
 	}
-	ok, err = s.p.IsPlayerNameAvailable(creds.Username)
-	if !ok {
-		handleError(w, http.StatusInternalServerError, err, false)
-		return
+	if plr.Name != creds.Username {
+		ok, err = s.p.IsPlayerNameAvailable(creds.Username)
+		if !ok {
+			handleError(w, http.StatusInternalServerError, err, false)
+			return
+		}
 	}
 	err = plr.ChangeName(creds.Username)
 	if err != nil {
@@ -0,0 +1,41 @@
+package tournaments
+
+import "testing"
+
+func TestCheckIn(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Name = "Cube Night"
+	trn.Format = FormatCube
+	trn.Phase = PhaseCheckIn
+	trn.GamesToWin = 2
+	trn.MaxPlayers = 8
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}}
+	if err := trn.EndPhase(); err == nil {
+		t.Errorf("want: error without any checked in players")
+	}
+	for _, pID := range []PlayerID{"1", "3"} {
+		if err := trn.CheckIn(pID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := trn.CheckIn("1"); err == nil {
+		t.Errorf("want: error for checking in twice")
+	}
+	if err := trn.EndPhase(); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Phase != PhaseDraft {
+		t.Errorf("want: phase %s, got: %s", PhaseDraft, trn.Phase)
+	}
+	if len(trn.Participants) != 2 || trn.isPlayerRegistered("2") {
+		t.Fatalf("want: no-show 2 dropped before seating, got: %v", trn.Participants)
+	}
+	seats := map[int]bool{}
+	for _, par := range trn.Participants {
+		seats[par.SeatIndex] = true
+	}
+	if !seats[0] || !seats[1] {
+		t.Errorf("want: seats 0 and 1 taken by present players, got: %v", trn.Participants)
+	}
+}
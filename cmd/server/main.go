@@ -1,9 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/apaschout/tournaments"
 	"github.com/cognicraft/event"
@@ -28,9 +32,28 @@ func main() {
 	}
 
 	s := tournaments.NewServer(db, es)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.RunScheduler(ctx)
+
+	srv := &http.Server{Addr: port, Handler: s}
+	// stop the scheduler and wait for open requests on interrupt
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		cancel()
+		err := srv.Shutdown(context.Background())
+		if err != nil {
+			log.Println(err)
+		}
+	}()
 	fmt.Printf("Server running on %s\n", port)
-	err = http.ListenAndServe(port, s)
-	if err != nil {
+	err = srv.ListenAndServe()
+	if err != nil && err != http.ErrServerClosed {
 		log.Fatal(err)
 	}
+	<-done
 }
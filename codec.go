@@ -40,6 +40,10 @@ func Codec() (*event.Codec, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.Register("tournament:player-checked-in", TournamentPlayerCheckedIn{})
+	if err != nil {
+		return nil, err
+	}
 	err = c.Register("tournament:started", TournamentStarted{})
 	if err != nil {
 		return nil, err
@@ -48,10 +52,42 @@ func Codec() (*event.Codec, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.Register("tournament:pairing-changed", TournamentPairingChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:seatpairing-changed", TournamentSeatPairingChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:numberofrounds-changed", TournamentNumberOfRoundsChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:roundtime-changed", TournamentRoundTimeChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:schedule-changed", TournamentScheduleChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:topcut-changed", TournamentTopCutChanged{})
+	if err != nil {
+		return nil, err
+	}
 	err = c.Register("tournament:matches-created", TournamentMatchesCreated{})
 	if err != nil {
 		return nil, err
 	}
+	err = c.Register("tournament:round-started", TournamentRoundStarted{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:round-ended", TournamentRoundEnded{})
+	if err != nil {
+		return nil, err
+	}
 	err = c.Register("tournament:game-ended", TournamentGameEnded{})
 	if err != nil {
 		return nil, err
@@ -60,6 +96,146 @@ func Codec() (*event.Codec, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.Register("tournament:playoffs-created", TournamentPlayoffsCreated{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:bracket-match-created", TournamentBracketMatchCreated{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:play-draw-chosen", TournamentPlayDrawChosen{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:champion-determined", TournamentChampionDetermined{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:deck-submitted", TournamentDeckSubmitted{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:decklists-waived", TournamentDecklistsWaived{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:cardlist-changed", TournamentCardListChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:poolsize-changed", TournamentPoolSizeChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:pools-opened", TournamentPoolsOpened{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:deck-built", TournamentDeckBuilt{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:teamsize-changed", TournamentTeamSizeChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:team-registered", TournamentTeamRegistered{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:team-dropped", TournamentTeamDropped{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:team-waitlisted", TournamentTeamWaitlisted{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:team-waitlist-promoted", TournamentTeamWaitlistPromoted{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:podsize-changed", TournamentPodSizeChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:placementpoints-changed", TournamentPlacementPointsChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:pod-ended", TournamentPodEnded{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:bye-awarded", TournamentByeAwarded{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:pointssystem-changed", TournamentPointsSystemChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:tiebreakers-changed", TournamentTiebreakersChanged{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:result-reported", TournamentResultReported{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:result-confirmed", TournamentResultConfirmed{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:result-disputed", TournamentResultDisputed{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:game-corrected", TournamentGameCorrected{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:match-corrected", TournamentMatchCorrected{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:infraction-recorded", TournamentInfractionRecorded{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:roundclock-started", TournamentRoundClockStarted{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:time-called", TournamentTimeCalled{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:draw-offered", TournamentDrawOffered{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:intentional-draw-recorded", TournamentIntentionalDrawRecorded{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:table-assigned", TournamentTableAssigned{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:fixed-table-assigned", TournamentFixedTableAssigned{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:player-waitlisted", TournamentPlayerWaitlisted{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tournament:waitlist-promoted", TournamentWaitlistPromoted{})
+	if err != nil {
+		return nil, err
+	}
 
 	err = c.Register("player:created", PlayerCreated{})
 	if err != nil {
@@ -77,6 +253,14 @@ func Codec() (*event.Codec, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.Register("player:claim-code-issued", PlayerClaimCodeIssued{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("player:claimed", PlayerClaimed{})
+	if err != nil {
+		return nil, err
+	}
 
 	err = c.Register("tracker:created", TrackerCreated{})
 	if err != nil {
@@ -98,5 +282,22 @@ func Codec() (*event.Codec, error) {
 	if err != nil {
 		return nil, err
 	}
+	err = c.Register("tracker:match-win-revoked", TrackerMatchWinRevoked{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("tracker:game-win-revoked", TrackerGameWinRevoked{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.Register("team:created", TeamCreated{})
+	if err != nil {
+		return nil, err
+	}
+	err = c.Register("team:tournament-registered", TeamTournamentRegistered{})
+	if err != nil {
+		return nil, err
+	}
 	return c, nil
 }
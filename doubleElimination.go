@@ -0,0 +1,132 @@
+package tournaments
+
+import "sort"
+
+// bracketSource describes where a player of a bracket match comes from:
+// the winner or the loser of an earlier bracket match.
+type bracketSource struct {
+	Bracket string
+	Round   int
+	Slot    int
+	Winner  bool
+}
+
+//call on TournamentMatchesCreated
+func (trn *Tournament) MakeDoubleEliminationMatches() {
+	parts := []Participant{}
+	for _, par := range trn.Participants {
+		if !par.Dropped {
+			parts = append(parts, par)
+		}
+	}
+	sort.SliceStable(parts, func(i, j int) bool {
+		return parts[i].SeatIndex < parts[j].SeatIndex
+	})
+	trn.Seeds = []PlayerID{}
+	for _, par := range parts {
+		trn.Seeds = append(trn.Seeds, par.Player)
+	}
+	trn.makeFirstBracketRound(BracketWinners, false)
+}
+
+// losersRoundSize returns the number of matches in the given losers bracket round
+// for a double elimination bracket of n players.
+func losersRoundSize(n int, round int) int {
+	return n >> uint((round+1)/2+1)
+}
+
+// doubleEliminationSources returns the sources of both players of a bracket match.
+// The first round of the winners bracket is seeded and has no sources.
+func doubleEliminationSources(n int, bracket string, round int, slot int) (bracketSource, bracketSource) {
+	k := bracketRoundsFor(n)
+	switch bracket {
+	case BracketWinners:
+		return bracketSource{BracketWinners, round - 1, 2 * slot, true}, bracketSource{BracketWinners, round - 1, 2*slot + 1, true}
+	case BracketLosers:
+		if round == 1 {
+			return bracketSource{BracketWinners, 1, 2 * slot, false}, bracketSource{BracketWinners, 1, 2*slot + 1, false}
+		}
+		if round%2 != 0 {
+			return bracketSource{BracketLosers, round - 1, 2 * slot, true}, bracketSource{BracketLosers, round - 1, 2*slot + 1, true}
+		}
+		// losers dropping down are fed in reverse order to postpone rematches
+		size := losersRoundSize(n, round)
+		return bracketSource{BracketLosers, round - 1, slot, true}, bracketSource{BracketWinners, round/2 + 1, size - 1 - slot, false}
+	default:
+		if k == 1 {
+			return bracketSource{BracketWinners, 1, 0, true}, bracketSource{BracketWinners, 1, 0, false}
+		}
+		return bracketSource{BracketWinners, k, 0, true}, bracketSource{BracketLosers, 2 * (k - 1), 0, true}
+	}
+}
+
+// resolveSource returns the player determined by src. ok is false if the source match
+// has not ended yet. The loser of a bye is "", as is the winner of a match without players.
+func (trn *Tournament) resolveSource(src bracketSource) (pID PlayerID, ok bool) {
+	idx := trn.bracketMatch(src.Bracket, src.Round, src.Slot)
+	if idx < 0 || !trn.Matches[idx].Ended {
+		return "", false
+	}
+	if src.Winner {
+		return trn.Matches[idx].Winner, true
+	}
+	return loserOf(trn.Matches[idx]), true
+}
+
+// advanceDoubleElimination creates every bracket match whose players have been determined
+// and determines the champion once the grand final has been decided. Positions with
+// a missing player are created as byes, so the bracket can be padded to a power of two.
+func (trn *Tournament) advanceDoubleElimination() error {
+	n := bracketSize(len(trn.Seeds))
+	k := bracketRoundsFor(n)
+	positions := []bracketSource{}
+	for r := 2; r <= k; r++ {
+		for s := 0; s < n>>uint(r); s++ {
+			positions = append(positions, bracketSource{Bracket: BracketWinners, Round: r, Slot: s})
+		}
+	}
+	for r := 1; r <= 2*(k-1); r++ {
+		for s := 0; s < losersRoundSize(n, r); s++ {
+			positions = append(positions, bracketSource{Bracket: BracketLosers, Round: r, Slot: s})
+		}
+	}
+	positions = append(positions, bracketSource{Bracket: BracketGrandFinal, Round: 1})
+	for _, pos := range positions {
+		if trn.bracketMatch(pos.Bracket, pos.Round, pos.Slot) >= 0 {
+			continue
+		}
+		a, b := doubleEliminationSources(n, pos.Bracket, pos.Round, pos.Slot)
+		p1, ok1 := trn.resolveSource(a)
+		p2, ok2 := trn.resolveSource(b)
+		if !ok1 || !ok2 {
+			continue
+		}
+		if pos.Bracket != BracketGrandFinal && p2 != "" && trn.seedOf(p2) < trn.seedOf(p1) {
+			p1, p2 = p2, p1
+		}
+		if p1 == "" {
+			p1, p2 = p2, p1
+		}
+		err = trn.CreateBracketMatch(pos.Bracket, pos.Round, pos.Slot, p1, p2)
+		if err != nil {
+			return err
+		}
+	}
+	final := trn.bracketMatch(BracketGrandFinal, 1, 0)
+	if trn.Champion != "" || final < 0 || !trn.Matches[final].Ended {
+		return nil
+	}
+	gf := trn.Matches[final]
+	if gf.Winner == gf.Player1 {
+		return trn.DetermineChampion(gf.Winner)
+	}
+	// the player coming from the losers bracket won, so the bracket is reset
+	reset := trn.bracketMatch(BracketGrandFinal, 2, 0)
+	if reset < 0 {
+		return trn.CreateBracketMatch(BracketGrandFinal, 2, 0, gf.Player1, gf.Player2)
+	}
+	if trn.Matches[reset].Ended {
+		return trn.DetermineChampion(trn.Matches[reset].Winner)
+	}
+	return nil
+}
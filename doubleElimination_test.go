@@ -0,0 +1,103 @@
+package tournaments
+
+import "testing"
+
+func TestDoubleElimination(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 1
+	trn.Pairing = PairingDoubleElimination
+	for i, p := range []PlayerID{"1", "2", "3", "4"} {
+		trn.Participants = append(trn.Participants, Participant{Player: p, SeatIndex: i})
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+
+	// the higher seed wins every match, except in the grand final
+	for i := 0; i < len(trn.Matches); i++ {
+		m := trn.Matches[i]
+		wnr := m.Player1
+		if m.Bracket == BracketGrandFinal {
+			wnr = m.Player2
+		}
+		trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: wnr})
+		trn.Mutate(TournamentMatchEnded{Match: i, Winner: wnr})
+		err := trn.advanceDoubleElimination()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []struct {
+		bracket string
+		round   int
+		p1, p2  PlayerID
+	}{
+		{BracketWinners, 1, "1", "4"},
+		{BracketWinners, 1, "2", "3"},
+		{BracketWinners, 2, "1", "2"},
+		{BracketLosers, 1, "3", "4"},
+		{BracketLosers, 2, "2", "3"},
+		{BracketGrandFinal, 1, "1", "2"},
+		{BracketGrandFinal, 2, "1", "2"},
+	}
+	if len(trn.Matches) != len(want) {
+		t.Fatalf("want: %d matches, got %d: %v", len(want), len(trn.Matches), trn.Matches)
+	}
+	for i, w := range want {
+		m := trn.Matches[i]
+		if m.Bracket != w.bracket || m.BracketRound != w.round || m.Player1 != w.p1 || m.Player2 != w.p2 {
+			t.Errorf("match %d: want %v, got %v", i, w, m)
+		}
+	}
+	if trn.Champion != "2" {
+		t.Errorf("want: champion %s, got %s", "2", trn.Champion)
+	}
+}
+
+func TestDoubleEliminationWithByes(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 1
+	trn.Pairing = PairingDoubleElimination
+	for i, p := range []PlayerID{"1", "2", "3", "4", "5"} {
+		trn.Participants = append(trn.Participants, Participant{Player: p, SeatIndex: i})
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	byes := 0
+	for _, m := range trn.Matches {
+		if m.Bye {
+			byes++
+		}
+	}
+	if len(trn.Matches) != 4 || byes != 3 {
+		t.Fatalf("want: 4 matches with byes for the top 3 seeds, got: %v", trn.Matches)
+	}
+	if err := trn.advanceDoubleElimination(); err != nil {
+		t.Fatal(err)
+	}
+	// the higher seed wins every match
+	for i := 0; i < len(trn.Matches); i++ {
+		m := trn.Matches[i]
+		if m.Ended {
+			continue
+		}
+		trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: m.Player1})
+		trn.Mutate(TournamentMatchEnded{Match: i, Winner: m.Player1})
+		if err := trn.advanceDoubleElimination(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if trn.Champion != "1" {
+		t.Fatalf("want: champion %s, got %q: %v", "1", trn.Champion, trn.Matches)
+	}
+	losses := map[PlayerID]int{}
+	for _, m := range trn.Matches {
+		if !m.Bye && m.Player2 != "" {
+			losses[loserOf(m)]++
+		}
+	}
+	for _, p := range []PlayerID{"2", "3", "4", "5"} {
+		if losses[p] != 2 {
+			t.Errorf("want: player %s eliminated after 2 losses, got: %d", p, losses[p])
+		}
+	}
+}
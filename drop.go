@@ -0,0 +1,130 @@
+package tournaments
+
+// isDropped reports whether pID has dropped from the tournament after registration.
+func (trn *Tournament) isDropped(pID PlayerID) bool {
+	par := trn.getParticipantByID(pID)
+	return par != nil && par.Dropped
+}
+
+// activePlayers returns all participants that have not dropped, in participant order.
+func (trn *Tournament) activePlayers() []PlayerID {
+	res := []PlayerID{}
+	for _, par := range trn.Participants {
+		if !par.Dropped {
+			res = append(res, par.Player)
+		}
+	}
+	return res
+}
+
+//call on TournamentPlayerDropped
+// manageDrop keeps pID in the standings as dropped. Unended matches of pID in the
+// current round are forfeited to the opponent, matches of rounds that have not started
+// are removed and pID leaves unended pods.
+func (trn *Tournament) manageDrop(pID PlayerID) {
+	par := trn.getParticipantByID(pID)
+	if par == nil {
+		return
+	}
+	par.Dropped = true
+	for _, i := range trn.forfeitableMatches(pID) {
+		trn.forfeitMatch(i)
+	}
+	// backwards, so that removing a match keeps the indices still to be visited
+	for i := len(trn.Matches) - 1; i >= 0; i-- {
+		m := trn.Matches[i]
+		if !m.Ended && trn.isUpcoming(m.Round) && trn.sideOf(m, pID) != "" {
+			trn.removeMatch(i)
+		}
+	}
+	for i := range trn.Pods {
+		pod := &trn.Pods[i]
+		if pod.Ended || !containsPlayer(pod.Players, pID) {
+			continue
+		}
+		plrs := []PlayerID{}
+		for _, p := range pod.Players {
+			if p != pID {
+				plrs = append(plrs, p)
+			}
+		}
+		pod.Players = plrs
+	}
+	trn.recountParticipants()
+}
+
+//call on TournamentTeamDropped
+// manageTeamDrop keeps the team in the standings as dropped and forfeits the matches of all its members.
+func (trn *Tournament) manageTeamDrop(tID TeamID) {
+	team := trn.getTeamByID(tID)
+	if team == nil {
+		return
+	}
+	team.Dropped = true
+	// all members are dropped before any match is forfeited, teammates may share a side
+	for _, pID := range team.Members {
+		if par := trn.getParticipantByID(pID); par != nil {
+			par.Dropped = true
+		}
+	}
+	for _, pID := range team.Members {
+		trn.manageDrop(pID)
+	}
+}
+
+// isUpcoming reports whether round has not been started yet. Tournaments paired
+// before rounds were recorded have no upcoming rounds.
+func (trn *Tournament) isUpcoming(round int) bool {
+	return len(trn.Rounds) > 0 && round > trn.currentRound()
+}
+
+// removeMatch removes a match that has never been played. Infractions keep pointing
+// at their matches.
+func (trn *Tournament) removeMatch(match int) {
+	trn.Matches = append(trn.Matches[:match], trn.Matches[match+1:]...)
+	for i := range trn.Infractions {
+		if trn.Infractions[i].Match > match {
+			trn.Infractions[i].Match--
+		}
+	}
+}
+
+// forfeitableMatches returns the indices of all unended matches of the current round
+// in which any of pIDs plays against an opponent. Round robin creates all matches
+// at once ordered by round, so these come before any match of an upcoming round.
+func (trn *Tournament) forfeitableMatches(pIDs ...PlayerID) []int {
+	res := []int{}
+	for i, m := range trn.Matches {
+		if m.Ended || m.Bye || m.Player1 == "" || m.Player2 == "" || trn.isUpcoming(m.Round) {
+			continue
+		}
+		for _, pID := range pIDs {
+			if trn.sideOf(m, pID) != "" {
+				res = append(res, i)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// forfeitMatch ends an unended match in which a dropped player takes part as a regular win
+// of the opponent. Games played so far are kept.
+func (trn *Tournament) forfeitMatch(match int) {
+	m := &trn.Matches[match]
+	if m.Ended || m.Bye || m.Player1 == "" || m.Player2 == "" {
+		return
+	}
+	switch {
+	case trn.isDropped(m.Player1):
+		m.Winner = m.Player2
+	case trn.isDropped(m.Player2):
+		m.Winner = m.Player1
+	default:
+		return
+	}
+	m.Report = nil
+	m.AdditionalTurns = false
+	m.DrawOffer = ""
+	m.Ended = true
+}
@@ -0,0 +1,197 @@
+package tournaments
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cognicraft/event"
+)
+
+func TestDropPlayer(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 2
+	trn.Phase = PhaseRounds
+	trn.Pairing = PairingSwiss
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}}
+	trn.Matches = []Match{
+		{Player1: "1", Player2: "3", Round: 1, Games: []Game{{}}},
+		{Player1: "2", Player2: "4", Round: 1, Games: []Game{{}}, Table: 2},
+	}
+	for g := 0; g < 2; g++ {
+		trn.Mutate(TournamentGameEnded{Match: 0, Game: g, Winner: "3"})
+	}
+	trn.Mutate(TournamentMatchEnded{Match: 0, Winner: "3"})
+	trn.Mutate(TournamentGameEnded{Match: 1, Game: 0, Winner: "4"})
+	trn.Mutate(TournamentPlayerDropped{Player: "4"})
+	if err := trn.DropPlayer("4"); err == nil {
+		t.Errorf("want: error for dropping twice")
+	}
+	par := trn.getParticipantByID("4")
+	if par == nil || !par.Dropped {
+		t.Fatalf("want: dropped participant 4, got: %v", trn.Participants)
+	}
+	if m := trn.Matches[1]; m.Bye || !m.Ended || m.Player2 != "4" || m.Winner != "2" || m.Table != 2 || len(m.Games) != 2 {
+		t.Errorf("want: unended match forfeited to 2, got: %v", m)
+	}
+	if trn.hadBye("2") {
+		t.Errorf("want: forfeit not counted as a bye")
+	}
+	if par.Games != 1 || par.GameWins != 1 || par.Matches != 1 || trn.getParticipantByID("2").MatchWins != 1 {
+		t.Errorf("want: forfeited match counted as a regular win, got: %v", trn.Participants)
+	}
+	if plrs := trn.activePlayers(); len(plrs) != 3 || containsPlayer(plrs, "4") {
+		t.Errorf("want: 4 excluded from pairing, got: %v", plrs)
+	}
+	if trn.unpairedPlayers(2)[0] == "4" {
+		t.Errorf("want: no bye for dropped player")
+	}
+	// results of a dropped player still count for the opponents
+	if opps := trn.opponents("1"); len(opps) != 1 || opps[0] != "3" {
+		t.Errorf("want: opponents [3], got: %v", opps)
+	}
+	trn.Mutate(TournamentPlayerDropped{Player: "3"})
+	if len(trn.standings()) != 4 || trn.getParticipantByID("3").MatchWins != 1 {
+		t.Errorf("want: dropped players kept in standings, got: %v", trn.standings())
+	}
+
+	trn.Phase = PhaseRegistration
+	trn.Mutate(TournamentPlayerDropped{Player: "1"})
+	if trn.getParticipantByID("1") != nil {
+		t.Errorf("want: player removed during registration")
+	}
+}
+
+func TestWaitlist(t *testing.T) {
+	s, cleanup := newTestServer(t, "1", "2", "3", "4")
+	defer cleanup()
+	trn := NewTournament(s)
+	trn.ID = "t"
+	trn.Phase = PhaseRegistration
+	trn.MaxPlayers = 2
+	for _, p := range []PlayerID{"1", "2", "3", "4"} {
+		if err := trn.RegisterPlayer(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := trn.RegisterPlayer("3"); err == nil {
+		t.Errorf("want: error for joining the waitlist twice")
+	}
+	if err := trn.RegisterPlayer("unknown"); err == nil {
+		t.Errorf("want: error for waitlisting an unknown player")
+	}
+	if len(trn.Participants) != 2 || len(trn.Waitlist) != 2 || trn.Waitlist[0] != "3" {
+		t.Fatalf("want: 3 and 4 waitlisted, got: %v %v", trn.Participants, trn.Waitlist)
+	}
+	if err := trn.ChangeMaxPlayers(1); err == nil {
+		t.Errorf("want: error for MaxPlayers below the registered players")
+	}
+	if err := trn.DropPlayer("4"); err != nil {
+		t.Fatal(err)
+	}
+	if len(trn.Waitlist) != 1 {
+		t.Errorf("want: 4 left the waitlist, got: %v", trn.Waitlist)
+	}
+	if err := trn.DropPlayer("1"); err != nil {
+		t.Fatal(err)
+	}
+	if !trn.isPlayerRegistered("3") || len(trn.Waitlist) != 0 {
+		t.Errorf("want: 3 promoted, got: %v %v", trn.Participants, trn.Waitlist)
+	}
+}
+
+func TestTeamWaitlist(t *testing.T) {
+	s, cleanup := newTestServer(t, "1", "2", "3", "4", "5", "6")
+	defer cleanup()
+	trn := NewTournament(s)
+	trn.ID = "t"
+	trn.Phase = PhaseRegistration
+	trn.TeamSize = TeamSizeTwoHeadedGiant
+	trn.MaxPlayers = 3
+	if err := trn.RegisterTeam("A", []PlayerID{"1", "2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := trn.RegisterTeam("B", []PlayerID{"3", "4"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := trn.RegisterTeam("C", []PlayerID{"5", "unknown"}); err == nil {
+		t.Errorf("want: error for waitlisting a team with an unknown player")
+	}
+	if err := trn.RegisterTeam("D", []PlayerID{"4", "5"}); err == nil {
+		t.Errorf("want: error for a player already on the waitlist")
+	}
+	if len(trn.Teams) != 1 || len(trn.TeamWaitlist) != 1 || trn.TeamWaitlist[0].Name != "B" {
+		t.Fatalf("want: B waitlisted, got: %v %v", trn.Teams, trn.TeamWaitlist)
+	}
+	if err := trn.ChangeMaxPlayers(4); err != nil {
+		t.Fatal(err)
+	}
+	if len(trn.Teams) != 2 || len(trn.TeamWaitlist) != 0 || !trn.isPlayerRegistered("4") {
+		t.Fatalf("want: B promoted, got: %v %v", trn.Teams, trn.TeamWaitlist)
+	}
+	if err := trn.RegisterTeam("C", []PlayerID{"5", "6"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := trn.DropPlayer("6"); err != nil {
+		t.Fatal(err)
+	}
+	if len(trn.TeamWaitlist) != 0 || len(trn.Participants) != 4 {
+		t.Errorf("want: C left the waitlist, got: %v %v", trn.Participants, trn.TeamWaitlist)
+	}
+}
+
+// newTestServer returns a Server backed by a temporary event store holding the given players.
+func newTestServer(t *testing.T, pIDs ...PlayerID) (*Server, func()) {
+	dir, err := ioutil.TempDir("", "tournaments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	es, err := event.NewStore(filepath.Join(dir, "events.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{es: es}
+	for _, pID := range pIDs {
+		plr := NewPlayer(s)
+		if err := plr.Create(pID, TrackerID("trk-"+pID), "player", "", ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := plr.Save(es, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s, func() {
+		es.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestDropRemovesUpcomingMatches(t *testing.T) {
+	trn := Tournament{Pairing: PairingRoundRobin, GamesToWin: 1, Phase: PhaseRounds}
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	trn.Mutate(TournamentRoundStarted{Round: 1})
+	if len(trn.Matches) != 6 {
+		t.Fatalf("want: %d matches, got: %d", 6, len(trn.Matches))
+	}
+	trn.Mutate(TournamentPlayerDropped{Player: "4"})
+	if len(trn.Matches) != 4 {
+		t.Fatalf("want: upcoming matches of 4 removed, got: %v", trn.Matches)
+	}
+	for _, m := range trn.Matches {
+		if m.Player1 != "4" && m.Player2 != "4" {
+			continue
+		}
+		if m.Round != 1 || !m.Ended || m.Bye || m.Winner == "4" {
+			t.Errorf("want: only the current match of 4 forfeited, got: %v", m)
+		}
+	}
+	if par := trn.getParticipantByID("4"); par.Matches != 1 {
+		t.Errorf("want: only the forfeited match counted for 4, got: %v", *par)
+	}
+	if u := trn.unpairedPlayers(2); len(u) != 1 {
+		t.Errorf("want: the opponent of 4 unpaired in round 2, got: %v", u)
+	}
+}
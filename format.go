@@ -1,11 +1,166 @@
 package tournaments
 
+import (
+	"fmt"
+	"sort"
+)
+
 type Phase string
 
 const (
 	PhaseRegistration   = "registration"
+	PhaseCheckIn        = "check-in"
 	PhaseInitialization = "initialization"
 	PhaseDraft          = "draft"
+	PhaseDeckSubmission = "deck-submission"
+	PhasePoolOpening    = "pool-opening"
 	PhaseRounds         = "rounds"
+	PhasePlayoffs       = "playoffs"
 	PhaseEnded          = "ended"
 )
+
+const (
+	FormatCube        = "cube"
+	FormatConstructed = "constructed"
+	FormatSealed      = "sealed"
+)
+
+// Format defines how a tournament is run: which phases it goes through,
+// how players are paired, how standings are determined and which actions are allowed.
+type Format interface {
+	// Name returns the name the format is registered under.
+	Name() string
+	// Phases returns the phases of the format in the order they are played.
+	Phases() []Phase
+	// Pairings returns the pairing strategies supported by the format.
+	Pairings() []string
+	// Standings returns the participants of trn ordered by their current standing.
+	Standings(trn *Tournament) []PlayerID
+	// Allows reports whether action may be used in a tournament of the format.
+	Allows(action string) bool
+}
+
+var formats = map[string]Format{}
+
+// formatSpecificActions are only allowed in formats that explicitly list them.
+var formatSpecificActions = []string{
+	ActionSubmitDeck,
+	ActionWaiveDecklists,
+	ActionChangeCardList,
+	ActionChangePoolSize,
+	ActionBuildDeck,
+	ActionChangeSeatPairing,
+}
+
+func init() {
+	RegisterFormat(&standardFormat{
+		name:     FormatCube,
+		phases:   []Phase{PhaseInitialization, PhaseRegistration, PhaseCheckIn, PhaseDraft, PhaseRounds, PhasePlayoffs, PhaseEnded},
+		pairings: pairings,
+		actions:  []string{ActionChangeSeatPairing},
+	})
+	RegisterFormat(&standardFormat{
+		name:     FormatConstructed,
+		phases:   []Phase{PhaseInitialization, PhaseRegistration, PhaseDeckSubmission, PhaseRounds, PhasePlayoffs, PhaseEnded},
+		pairings: pairings,
+		actions:  []string{ActionSubmitDeck, ActionWaiveDecklists},
+	})
+	RegisterFormat(&standardFormat{
+		name:     FormatSealed,
+		phases:   []Phase{PhaseInitialization, PhaseRegistration, PhasePoolOpening, PhaseDeckSubmission, PhaseRounds, PhasePlayoffs, PhaseEnded},
+		pairings: pairings,
+		actions:  []string{ActionChangeCardList, ActionChangePoolSize, ActionBuildDeck, ActionWaiveDecklists},
+	})
+}
+
+// RegisterFormat makes a format available to tournaments.
+// It panics if a format with the same name has already been registered.
+func RegisterFormat(f Format) {
+	if _, ok := formats[f.Name()]; ok {
+		panic(fmt.Sprintf("Format already registered: %s", f.Name()))
+	}
+	formats[f.Name()] = f
+}
+
+func formatByName(name string) (Format, bool) {
+	f, ok := formats[name]
+	return f, ok
+}
+
+// formatNames returns the names of all registered formats in alphabetical order.
+func formatNames() []string {
+	res := []string{}
+	for name := range formats {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// standardFormat is a Format described entirely by its fields.
+type standardFormat struct {
+	name     string
+	phases   []Phase
+	pairings []string
+	actions  []string
+}
+
+func (f *standardFormat) Name() string {
+	return f.name
+}
+
+func (f *standardFormat) Phases() []Phase {
+	return f.phases
+}
+
+func (f *standardFormat) Pairings() []string {
+	return f.pairings
+}
+
+func (f *standardFormat) Standings(trn *Tournament) []PlayerID {
+	return trn.rankParticipants()
+}
+
+func (f *standardFormat) Allows(action string) bool {
+	if !containsString(formatSpecificActions, action) {
+		return true
+	}
+	return containsString(f.actions, action)
+}
+
+// allows reports whether action may be used in trn. Without a known format
+// only actions that are not specific to a format are allowed.
+func (trn *Tournament) allows(action string) bool {
+	f, ok := formatByName(trn.Format)
+	if !ok {
+		return !containsString(formatSpecificActions, action)
+	}
+	return f.Allows(action)
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// nextPhase returns the phase following the current one in f, skipping phases
+// the tournament has no use for, or "" if there is none.
+func (trn *Tournament) nextPhase(f Format) Phase {
+	phases := f.Phases()
+	for i, p := range phases {
+		if p != trn.Phase {
+			continue
+		}
+		for _, next := range phases[i+1:] {
+			if next == PhasePlayoffs && (trn.TopCut == 0 || trn.Pairing == PairingDoubleElimination) {
+				continue
+			}
+			return next
+		}
+	}
+	return ""
+}
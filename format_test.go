@@ -0,0 +1,65 @@
+package tournaments
+
+import "testing"
+
+func TestNextPhase(t *testing.T) {
+	f, ok := formatByName(FormatCube)
+	if !ok {
+		t.Fatalf("format %s not registered", FormatCube)
+	}
+	tests := []struct {
+		trn  Tournament
+		want Phase
+	}{
+		{Tournament{Phase: PhaseRegistration}, PhaseCheckIn},
+		{Tournament{Phase: PhaseCheckIn}, PhaseDraft},
+		{Tournament{Phase: PhaseRounds}, PhaseEnded},
+		{Tournament{Phase: PhaseRounds, TopCut: 4}, PhasePlayoffs},
+		{Tournament{Phase: PhaseRounds, TopCut: 4, Pairing: PairingDoubleElimination}, PhaseEnded},
+		{Tournament{Phase: PhaseEnded}, ""},
+	}
+	for _, test := range tests {
+		got := test.trn.nextPhase(f)
+		if got != test.want {
+			t.Errorf("%s: want: %q, got: %q", test.trn.Phase, test.want, got)
+		}
+	}
+}
+
+func TestConstructedRequiresDecklists(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Format = FormatConstructed
+	trn.Phase = PhaseDeckSubmission
+	trn.MaxPlayers = 8
+	trn.GamesToWin = 1
+	trn.Participants = []Participant{{Player: "1", Deck: "d1"}, {Player: "2"}}
+	if err := trn.EndPhase(); err == nil {
+		t.Fatalf("want: error for missing decklist")
+	}
+	if err := trn.WaiveDecklists(); err != nil {
+		t.Fatal(err)
+	}
+	if err := trn.EndPhase(); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Phase != PhaseRounds {
+		t.Errorf("want: %s, got: %s", PhaseRounds, trn.Phase)
+	}
+
+	// players who dropped without a decklist do not hold up the others
+	trn = NewTournament(nil)
+	trn.ID = "t"
+	trn.Format = FormatConstructed
+	trn.Phase = PhaseDeckSubmission
+	trn.MaxPlayers = 8
+	trn.GamesToWin = 1
+	trn.Participants = []Participant{{Player: "1", Deck: "d1"}, {Player: "2", Deck: "d2"}, {Player: "3"}}
+	trn.Mutate(TournamentPlayerDropped{Player: "3"})
+	if err := trn.EndPhase(); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Phase != PhaseRounds {
+		t.Errorf("want: %s, got: %s", PhaseRounds, trn.Phase)
+	}
+}
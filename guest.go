@@ -0,0 +1,81 @@
+package tournaments
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cognicraft/uuid"
+)
+
+// CreateGuest creates a player without an account for walk-ins. The guest can be
+// claimed with its claim code when the person signs up.
+func (s *Server) CreateGuest(name string) (*Player, error) {
+	if name == "" {
+		return nil, fmt.Errorf("A Guest's name may not be empty")
+	}
+	ok, err := s.p.IsPlayerNameAvailable(name)
+	if !ok {
+		return nil, err
+	}
+	plr := NewPlayer(s)
+	err = plr.Create(PlayerID(uuid.MakeV4()), TrackerID(uuid.MakeV4()), "guest", "", "")
+	if err != nil {
+		return nil, err
+	}
+	err = plr.ChangeName(name)
+	if err != nil {
+		return nil, err
+	}
+	err = plr.IssueClaimCode(uuid.MakeV4())
+	if err != nil {
+		return nil, err
+	}
+	err = plr.Save(s.es, nil)
+	if err != nil {
+		return nil, err
+	}
+	return plr, nil
+}
+
+func (plr *Player) IssueClaimCode(code string) error {
+	if plr.ID == "" {
+		return fmt.Errorf("Player does not exist")
+	}
+	if plr.Role != "guest" {
+		return fmt.Errorf("Only Guests can be claimed")
+	}
+	plr.Apply(PlayerClaimCodeIssued{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Player:     plr.ID,
+		Code:       code,
+	})
+	log.Printf("Event: Player %s: Claim Code issued\n", plr.ID)
+	return nil
+}
+
+// Claim turns a guest into a regular player with an account. The guest keeps its
+// ID, so its tournaments and stats become part of the account.
+func (plr *Player) Claim(code string, mail string, password string) error {
+	if plr.ID == "" {
+		return fmt.Errorf("Player does not exist")
+	}
+	if plr.Role != "guest" {
+		return fmt.Errorf("Only Guests can be claimed")
+	}
+	if plr.ClaimCode == "" || subtle.ConstantTimeCompare([]byte(code), []byte(plr.ClaimCode)) != 1 {
+		return fmt.Errorf("Invalid Claim Code")
+	}
+	plr.Apply(PlayerClaimed{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Player:     plr.ID,
+		Mail:       mail,
+		Password:   password,
+		Role:       "player",
+	})
+	log.Printf("Event: Player %s: Claimed\n", plr.ID)
+	return nil
+}
@@ -0,0 +1,41 @@
+package tournaments
+
+import "testing"
+
+func TestClaimGuest(t *testing.T) {
+	plr := NewPlayer(nil)
+	plr.Mutate(PlayerCreated{Player: "g", Role: "guest", Tracker: "t"})
+	if err := plr.Claim("", "walk-in@example.com", "pw"); err == nil {
+		t.Errorf("want: error for claiming without a claim code")
+	}
+	if err := plr.IssueClaimCode("secret"); err != nil {
+		t.Fatal(err)
+	}
+	if err := plr.Claim("wrong", "walk-in@example.com", "pw"); err == nil {
+		t.Errorf("want: error for a wrong claim code")
+	}
+	if err := plr.Claim("secret", "walk-in@example.com", "pw"); err != nil {
+		t.Fatal(err)
+	}
+	if plr.ID != "g" || plr.Role != "player" || plr.Mail != "walk-in@example.com" || plr.ClaimCode != "" {
+		t.Errorf("want: guest g claimed as player, got: %+v", plr)
+	}
+	if err := plr.Claim("secret", "other@example.com", "pw"); err == nil {
+		t.Errorf("want: error for claiming twice")
+	}
+}
+
+func TestCheckPlayerRegistration(t *testing.T) {
+	trn := Tournament{Phase: PhaseRegistration}
+	if err := trn.checkPlayerRegistration(); err != nil {
+		t.Errorf("want: registration open, got: %v", err)
+	}
+	trn.TeamSize = TeamSizeTwoHeadedGiant
+	if err := trn.checkPlayerRegistration(); err == nil {
+		t.Errorf("want: error for a single player in a team tournament")
+	}
+	trn = Tournament{Phase: PhaseCheckIn}
+	if err := trn.checkPlayerRegistration(); err == nil {
+		t.Errorf("want: error for a guest after registration has closed")
+	}
+}
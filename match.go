@@ -1,16 +1,42 @@
 package tournaments
 
 type Match struct {
-	Player1 PlayerID `json:"player1"`
-	Player2 PlayerID `json:"player2"`
-	Winner  PlayerID `json:"winner"`
-	P1Count int      `json:"p1Count"`
-	P2Count int      `json:"p2Count"`
-	Games   []Game   `json:"games"`
-	Draw    bool     `json:"draw"`
-	Ended   bool     `json:"ended"`
+	Player1         PlayerID      `json:"player1"`
+	Player2         PlayerID      `json:"player2"`
+	Winner          PlayerID      `json:"winner"`
+	P1Count         int           `json:"p1Count"`
+	P2Count         int           `json:"p2Count"`
+	Games           []Game        `json:"games"`
+	Round           int           `json:"round"`
+	Bye             bool          `json:"bye"`
+	Team1           TeamID        `json:"team1,omitempty"`
+	Team2           TeamID        `json:"team2,omitempty"`
+	Bracket         string        `json:"bracket,omitempty"`
+	BracketRound    int           `json:"bracketRound,omitempty"`
+	Slot            int           `json:"slot"`
+	OnThePlay       PlayerID      `json:"onThePlay,omitempty"`
+	Playoff         bool          `json:"playoff,omitempty"`
+	Draw            bool          `json:"draw"`
+	Ended           bool          `json:"ended"`
+	Report          *ResultReport `json:"report,omitempty"`
+	AdditionalTurns bool          `json:"additionalTurns,omitempty"`
+	DrawOffer       PlayerID      `json:"drawOffer,omitempty"`
+	IntentionalDraw bool          `json:"intentionalDraw,omitempty"`
+	Table           int           `json:"table,omitempty"`
 }
 
+// ResultReport is a game result reported by a player, pending confirmation by the opponent.
+type ResultReport struct {
+	Game     int      `json:"game"`
+	Reporter PlayerID `json:"reporter"`
+	Winner   PlayerID `json:"winner"`
+	Draw     bool     `json:"draw"`
+	Disputed bool     `json:"disputed"`
+}
+
+// byeGameWins is the number of games a bye counts as won, i.e. a bye is a 2-0 match win.
+const byeGameWins = 2
+
 type Game struct {
 	Winner PlayerID `json:"winner"`
 	Draw   bool     `json:"draw"`
@@ -18,13 +44,24 @@ type Game struct {
 }
 
 func (trn *Tournament) MakeMatches() {
-	plrs := []PlayerID{}
-	for _, par := range trn.Participants {
-		plrs = append(plrs, par.Player)
-	}
+	trn.makeMatches("")
+}
+
+//call on TournamentMatchesCreated
+// makeMatches pairs all rounds at once. The first round follows the draft seats
+// according to seatPairing, if one was recorded with the matches.
+func (trn *Tournament) makeMatches(seatPairing string) {
+	plrs := trn.activePlayers()
 	if len(plrs)%2 != 0 {
-		// create dummy player, later delete matches with dummy
-		plrs = append(plrs, "x")
+		// the player paired with nobody receives a bye for that round
+		plrs = append(plrs, "")
+	}
+	if seats := trn.seatPairs(seatPairing); seats != nil {
+		// arrange players so that the first round follows the seating
+		plrs[0], plrs[1] = seats[0][0], seats[0][1]
+		for i := 1; i < len(seats); i++ {
+			plrs[1+i], plrs[len(plrs)-i] = seats[i][1], seats[i][0]
+		}
 	}
 
 	numRounds := len(plrs) - 1
@@ -36,41 +73,165 @@ func (trn *Tournament) MakeMatches() {
 
 	for round := 0; round < numRounds; round++ {
 		plrIdx := round % plrsLen
-		matches = append(matches, Match{Player1: plrs[0], Player2: withoutFirst[plrIdx], Games: []Game{{}}})
+		if withoutFirst[plrIdx] != "" {
+			matches = append(matches, Match{Player1: plrs[0], Player2: withoutFirst[plrIdx], Round: round + 1, Games: []Game{{}}})
+		}
 		for i := 1; i < halfSize; i++ {
 			plr1 := (round + plrsLen - i) % plrsLen
 			plr2 := (round + i) % plrsLen
-			matches = append(matches, Match{Player1: withoutFirst[plr1], Player2: withoutFirst[plr2], Games: []Game{{}}})
+			if withoutFirst[plr1] == "" || withoutFirst[plr2] == "" {
+				continue
+			}
+			matches = append(matches, Match{Player1: withoutFirst[plr1], Player2: withoutFirst[plr2], Round: round + 1, Games: []Game{{}}})
+		}
+	}
+	trn.Matches = matches
+}
+
+// sideOf returns the player of m whose side pID plays on, or "" if pID is not part of m.
+func (trn *Tournament) sideOf(m Match, pID PlayerID) PlayerID {
+	for _, side := range []PlayerID{m.Player1, m.Player2} {
+		if side != "" && containsPlayer(trn.sideMembers(m, side), pID) {
+			return side
 		}
 	}
-	trn.Matches = deleteDummyMatches(matches)
+	return ""
 }
 
-func deleteDummyMatches(matches []Match) []Match {
-	for i := 0; i < len(matches); i++ {
-		if matches[i].Player1 == "x" || matches[i].Player2 == "x" {
-			matches = append(matches[:i], matches[i+1:]...)
-			i--
+//call on TournamentGameCorrected
+func (trn *Tournament) recountGameWins(match int) {
+	m := &trn.Matches[match]
+	m.P1Count, m.P2Count = 0, 0
+	for _, g := range m.Games {
+		if !g.Ended || g.Draw {
+			continue
+		}
+		if g.Winner == m.Player1 {
+			m.P1Count++
+		} else if g.Winner == m.Player2 {
+			m.P2Count++
 		}
 	}
-	return matches
+}
+
+// gamesOutcome returns the winner of m by game wins, or a draw if both sides won equally often.
+func gamesOutcome(m Match) (PlayerID, bool) {
+	switch {
+	case m.P1Count > m.P2Count:
+		return m.Player1, false
+	case m.P2Count > m.P1Count:
+		return m.Player2, false
+	}
+	return "", true
+}
+
+// recountParticipants recomputes the counters of every participant from all results,
+// in the same way they are incremented when results come in.
+func (trn *Tournament) recountParticipants() {
+	for i := range trn.Participants {
+		par := &trn.Participants[i]
+		par.Matches, par.Games, par.MatchWins, par.GameWins = 0, 0, 0, 0
+	}
+	for _, m := range trn.Matches {
+		if m.Playoff {
+			continue
+		}
+		if m.Bye {
+			for _, pID := range trn.sideMembers(m, m.Player1) {
+				if part := trn.getParticipantByID(pID); part != nil {
+					part.Matches++
+					part.MatchWins++
+					part.Games += byeGameWins
+					part.GameWins += byeGameWins
+				}
+			}
+			continue
+		}
+		for _, side := range []PlayerID{m.Player1, m.Player2} {
+			for _, pID := range trn.sideMembers(m, side) {
+				part := trn.getParticipantByID(pID)
+				if part == nil {
+					continue
+				}
+				for _, g := range m.Games {
+					if !g.Ended {
+						continue
+					}
+					part.Games++
+					if g.Winner == side {
+						part.GameWins++
+					}
+				}
+				if m.Ended {
+					part.Matches++
+					if m.Winner == side {
+						part.MatchWins++
+					}
+				}
+			}
+		}
+	}
+	for i, pod := range trn.Pods {
+		if pod.Ended {
+			trn.managePodResult(i)
+		}
+	}
+}
+
+// unpairedPlayers returns all participants that have not dropped without a match in the given round.
+func (trn *Tournament) unpairedPlayers(round int) []PlayerID {
+	res := []PlayerID{}
+	for _, pID := range trn.activePlayers() {
+		paired := false
+		for _, m := range trn.Matches {
+			if m.Round == round && (m.Player1 == pID || m.Player2 == pID) {
+				paired = true
+				break
+			}
+		}
+		if !paired {
+			res = append(res, pID)
+		}
+	}
+	return res
+}
+
+//call on TournamentByeAwarded
+func (trn *Tournament) manageBye(round int, pID PlayerID) {
+	trn.Matches = append(trn.Matches, Match{Player1: pID, Winner: pID, P1Count: byeGameWins, Round: round, Bye: true, Ended: true})
+	part := trn.getParticipantByID(pID)
+	if part == nil {
+		return
+	}
+	part.Matches++
+	part.MatchWins++
+	part.Games += byeGameWins
+	part.GameWins += byeGameWins
 }
 
 //call on TournamentGameEnded
 func (trn *Tournament) manageGameWins(match int, game int) {
 	m := &trn.Matches[match]
 	g := &m.Games[game]
-	part1 := trn.getParticipantByID(m.Player1)
-	part2 := trn.getParticipantByID(m.Player2)
 	if g.Winner == m.Player1 {
 		m.P1Count++
-		part1.GameWins++
 	} else if g.Winner == m.Player2 {
 		m.P2Count++
-		part2.GameWins++
 	}
-	part1.Games++
-	part2.Games++
+	if !m.Playoff {
+		for _, side := range []PlayerID{m.Player1, m.Player2} {
+			for _, pID := range trn.sideMembers(*m, side) {
+				part := trn.getParticipantByID(pID)
+				if part == nil {
+					continue
+				}
+				if g.Winner == side {
+					part.GameWins++
+				}
+				part.Games++
+			}
+		}
+	}
 	if m.P1Count < trn.GamesToWin && m.P2Count < trn.GamesToWin {
 		m.Games = append(m.Games, Game{})
 	}
@@ -95,13 +256,19 @@ func (trn *Tournament) manageGameWins(match int, game int) {
 
 func (trn *Tournament) manageMatchWin(match int) {
 	m := &trn.Matches[match]
-	part1 := trn.getParticipantByID(m.Player1)
-	part2 := trn.getParticipantByID(m.Player2)
-	part1.Matches++
-	part2.Matches++
-	if m.Winner == part1.Player {
-		part1.MatchWins++
-	} else if m.Winner == part2.Player {
-		part2.MatchWins++
+	if m.Playoff {
+		return
+	}
+	for _, side := range []PlayerID{m.Player1, m.Player2} {
+		for _, pID := range trn.sideMembers(*m, side) {
+			part := trn.getParticipantByID(pID)
+			if part == nil {
+				continue
+			}
+			part.Matches++
+			if m.Winner == side {
+				part.MatchWins++
+			}
+		}
 	}
 }
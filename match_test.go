@@ -0,0 +1,159 @@
+package tournaments
+
+import "testing"
+
+func TestReportResult(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 2
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}}
+	trn.Matches = []Match{{Player1: "1", Player2: "2", Games: []Game{{}}}}
+	if err := trn.ReportResult(0, 0, "3", "3", false); err == nil {
+		t.Errorf("want: error for reporting a foreign match")
+	}
+	if err := trn.ReportResult(0, 0, "1", "1", false); err != nil {
+		t.Fatal(err)
+	}
+	if r := trn.Matches[0].Report; r == nil || r.Winner != "1" || trn.Matches[0].Games[0].Ended {
+		t.Fatalf("want: pending report, got: %v", trn.Matches[0])
+	}
+	if err := trn.ReportResult(0, 0, "2", "2", false); err == nil {
+		t.Errorf("want: error for a second report")
+	}
+	if err := trn.ConfirmResult(0, "1"); err == nil {
+		t.Errorf("want: error for confirming the own report")
+	}
+	if err := trn.DisputeResult(0, "2"); err != nil {
+		t.Fatal(err)
+	}
+	if !trn.Matches[0].Report.Disputed {
+		t.Errorf("want: disputed report")
+	}
+	if err := trn.ConfirmResult(0, "2"); err == nil {
+		t.Errorf("want: error for confirming a disputed report")
+	}
+	// a disputed report may be replaced by a new one
+	if err := trn.ReportResult(0, 0, "2", "1", false); err != nil {
+		t.Fatal(err)
+	}
+	trn.Mutate(TournamentResultConfirmed{Match: 0, Player: "1"})
+	trn.Mutate(TournamentGameEnded{Match: 0, Game: 0, Winner: "1"})
+	if trn.Matches[0].Report != nil || !trn.Matches[0].Games[0].Ended {
+		t.Errorf("want: game ended without pending report, got: %v", trn.Matches[0])
+	}
+}
+
+func TestCorrectResults(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 2
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}}
+	trn.Matches = []Match{{Player1: "1", Player2: "2", Round: 1, Games: []Game{{}}}}
+	trn.Mutate(TournamentByeAwarded{Round: 1, Player: "3"})
+	for g, wnr := range []PlayerID{"1", "2", "1"} {
+		trn.Mutate(TournamentGameEnded{Match: 0, Game: g, Winner: wnr})
+	}
+	trn.Mutate(TournamentMatchEnded{Match: 0, Winner: "1"})
+	if err := trn.CorrectMatch(1, "3", false, "o"); err == nil {
+		t.Errorf("want: error for correcting a bye")
+	}
+	if err := trn.CorrectGame(0, 0, "4", false, "o"); err == nil {
+		t.Errorf("want: error for a winner outside the match")
+	}
+	trn.Mutate(TournamentGameCorrected{Match: 0, Game: 2, OldWinner: "1", Winner: "2", Organizer: "o"})
+	trn.Mutate(TournamentMatchCorrected{Match: 0, OldWinner: "1", Winner: "2", Organizer: "o"})
+	m := trn.Matches[0]
+	if m.P1Count != 1 || m.P2Count != 2 || m.Winner != "2" {
+		t.Errorf("want: 1-2 win for player 2, got: %v", m)
+	}
+	p1, p2, p3 := trn.getParticipantByID("1"), trn.getParticipantByID("2"), trn.getParticipantByID("3")
+	if p1.MatchWins != 0 || p1.GameWins != 1 || p1.Games != 3 || p1.Matches != 1 {
+		t.Errorf("unexpected counters for player 1: %v", *p1)
+	}
+	if p2.MatchWins != 1 || p2.GameWins != 2 {
+		t.Errorf("unexpected counters for player 2: %v", *p2)
+	}
+	if p3.MatchWins != 1 || p3.GameWins != byeGameWins {
+		t.Errorf("want: bye kept for player 3, got: %v", *p3)
+	}
+	if trn.rankParticipants()[0] == "1" {
+		t.Errorf("want: player 1 no longer ranked first")
+	}
+}
+
+func TestCorrectDecidingGame(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 2
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}}
+	trn.Matches = []Match{
+		{Player1: "1", Player2: "2", Round: 1, Games: []Game{{}}},
+		{Player1: "3", Player2: "4", Round: 1, Games: []Game{{}}},
+	}
+	for g, wnr := range []PlayerID{"1", "2", "1"} {
+		trn.Mutate(TournamentGameEnded{Match: 0, Game: g, Winner: wnr})
+	}
+	trn.Mutate(TournamentMatchEnded{Match: 0, Winner: "1"})
+	trn.Mutate(TournamentGameCorrected{Match: 0, Game: 2, OldWinner: "1", Winner: "2", Organizer: "o"})
+	if m := trn.Matches[0]; !m.Ended || m.Draw || m.Winner != "2" {
+		t.Errorf("want: match won by 2 after the deciding game was flipped, got: %v", m)
+	}
+	if trn.getParticipantByID("1").MatchWins != 0 || trn.getParticipantByID("2").MatchWins != 1 {
+		t.Errorf("unexpected counters: %v", trn.Participants)
+	}
+	if err := trn.CorrectGame(0, 2, "", true, "o"); err == nil {
+		t.Errorf("want: error for a correction that leaves the match undecided")
+	}
+	if m := trn.Matches[0]; !m.Ended || m.Winner != "2" || m.P2Count != 2 {
+		t.Errorf("want: refused correction not applied, got: %v", m)
+	}
+	// a match lost by penalty keeps its result
+	trn.Mutate(TournamentGameEnded{Match: 1, Game: 0, Winner: "3"})
+	trn.Mutate(TournamentMatchEnded{Match: 1, Winner: "4"})
+	trn.Mutate(TournamentGameCorrected{Match: 1, Game: 0, OldWinner: "3", Draw: true, Organizer: "o"})
+	if m := trn.Matches[1]; m.Winner != "4" || m.Draw {
+		t.Errorf("want: penalty result kept, got: %v", m)
+	}
+}
+
+func TestIntentionalDraw(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 2
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}}
+	trn.Rounds = []Round{{Number: 1}}
+	trn.Matches = []Match{
+		{Player1: "1", Player2: "2", Round: 1, Games: []Game{{}}},
+		{Player1: "3", Player2: "4", Bracket: BracketSingle, BracketRound: 1, Games: []Game{{}}},
+	}
+	if err := trn.OfferIntentionalDraw(0, "3"); err == nil {
+		t.Errorf("want: error for offering a draw in a foreign match")
+	}
+	if err := trn.OfferIntentionalDraw(1, "3"); err == nil {
+		t.Errorf("want: error for a draw in a bracket match")
+	}
+	if err := trn.ConcludeMatch(1, true); err == nil {
+		t.Errorf("want: error for concluding a bracket match as a draw")
+	}
+	if err := trn.OfferIntentionalDraw(0, "1"); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Matches[0].DrawOffer != "1" {
+		t.Errorf("want: draw offered by 1, got: %v", trn.Matches[0])
+	}
+	if err := trn.OfferIntentionalDraw(0, "1"); err == nil {
+		t.Errorf("want: error for offering a draw twice")
+	}
+	trn.Mutate(TournamentIntentionalDrawRecorded{Match: 0})
+	trn.Mutate(TournamentMatchEnded{Match: 0, Draw: true})
+	m := trn.Matches[0]
+	if !m.Ended || !m.Draw || !m.IntentionalDraw || m.DrawOffer != "" {
+		t.Errorf("want: intentional draw, got: %v", m)
+	}
+	if par := trn.getParticipantByID("1"); par.Matches != 1 || par.MatchWins != 0 || trn.matchPoints("1") != 1 {
+		t.Errorf("want: one drawn match, got: %v", par)
+	}
+	if err := trn.ConcludeMatch(0, false); err == nil {
+		t.Errorf("want: error for concluding an ended match")
+	}
+}
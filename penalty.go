@@ -0,0 +1,132 @@
+package tournaments
+
+import (
+	"time"
+
+	"github.com/cognicraft/hyper"
+)
+
+const (
+	PenaltyWarning          = "warning"
+	PenaltyGameLoss         = "game-loss"
+	PenaltyMatchLoss        = "match-loss"
+	PenaltyDisqualification = "disqualification"
+)
+
+var penalties = []string{PenaltyWarning, PenaltyGameLoss, PenaltyMatchLoss, PenaltyDisqualification}
+
+// Infraction is a penalty a judge issued to a participant in a match.
+type Infraction struct {
+	Match      int       `json:"match"`
+	Player     PlayerID  `json:"player"`
+	Penalty    string    `json:"penalty"`
+	Reason     string    `json:"reason,omitempty"`
+	Judge      PlayerID  `json:"judge"`
+	OccurredOn time.Time `json:"occurredOn"`
+}
+
+// InfractionView is an Infraction together with the names of the penalized player and the judge.
+type InfractionView struct {
+	Infraction
+	PlayerName string `json:"playerName"`
+	JudgeName  string `json:"judgeName"`
+}
+
+// infractionViews resolves the names of everyone involved in the recorded infractions.
+func (trn *Tournament) infractionViews() []InfractionView {
+	res := []InfractionView{}
+	for _, inf := range trn.Infractions {
+		res = append(res, InfractionView{
+			Infraction: inf,
+			PlayerName: trn.Server.playerName(inf.Player),
+			JudgeName:  trn.Server.playerName(inf.Judge),
+		})
+	}
+	return res
+}
+
+// playerName returns the name of pID, or pID itself if it can not be resolved.
+func (s *Server) playerName(pID PlayerID) string {
+	plr, err := s.p.FindPlayerByID(pID)
+	if err != nil || plr.Name == "" {
+		return string(pID)
+	}
+	return plr.Name
+}
+
+// infractionsOf returns all infractions recorded against pID.
+func (trn *Tournament) infractionsOf(pID PlayerID) []Infraction {
+	res := []Infraction{}
+	for _, inf := range trn.Infractions {
+		if inf.Player == pID {
+			res = append(res, inf)
+		}
+	}
+	return res
+}
+
+// opponentOf returns the side of m that pID is not part of.
+func (trn *Tournament) opponentOf(m Match, pID PlayerID) PlayerID {
+	if trn.sideOf(m, pID) == m.Player1 {
+		return m.Player2
+	}
+	return m.Player1
+}
+
+// MakeInfractionsHyperItem lists the infractions of pID in all of its tournaments.
+// Tournaments that have been deleted or fail to load are skipped.
+func (s *Server) MakeInfractionsHyperItem(resolve hyper.ResolverFunc, plr *Player) hyper.Item {
+	res := hyper.Item{
+		Label: "Infractions",
+		Type:  "infractions",
+	}
+	for _, tID := range plr.Tournaments {
+		trn, err := LoadTournament(s, tID)
+		if err != nil {
+			continue
+		}
+		for _, inf := range trn.infractionsOf(plr.ID) {
+			item := hyper.Item{
+				Type: "infraction",
+				Properties: []hyper.Property{
+					{
+						Label: "Tournament",
+						Name:  "tournament",
+						Value: trn.Name,
+					},
+					{
+						Label: "Match",
+						Name:  "match",
+						Value: inf.Match + 1,
+					},
+					{
+						Label: "Penalty",
+						Name:  "penalty",
+						Value: inf.Penalty,
+					},
+					{
+						Label: "Reason",
+						Name:  "reason",
+						Value: inf.Reason,
+					},
+					{
+						Label: "Judge",
+						Name:  "judge",
+						Value: s.playerName(inf.Judge),
+					},
+					{
+						Label: "Time",
+						Name:  "time",
+						Value: inf.OccurredOn,
+					},
+				},
+			}
+			item.AddLink(hyper.Link{
+				Rel:  "details",
+				Href: resolve("../tournaments/%s", trn.ID).String(),
+			})
+			res.AddItem(item)
+		}
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+package tournaments
+
+import "testing"
+
+func TestRecordInfraction(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.GamesToWin = 2
+	trn.Phase = PhaseRounds
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}}
+	trn.Matches = []Match{{Player1: "1", Player2: "2", Round: 1, Games: []Game{{}}}}
+	trn.Mutate(TournamentByeAwarded{Round: 1, Player: "3"})
+	if err := trn.RecordInfraction(0, "3", PenaltyWarning, "", "j"); err == nil {
+		t.Errorf("want: error for a player outside the match")
+	}
+	if err := trn.RecordInfraction(1, "3", PenaltyWarning, "", "j"); err == nil {
+		t.Errorf("want: error for a bye")
+	}
+	if err := trn.RecordInfraction(0, "1", "jail", "", "j"); err == nil {
+		t.Errorf("want: error for an unknown penalty")
+	}
+	if err := trn.RecordInfraction(0, "1", PenaltyWarning, "slow play", "j"); err != nil {
+		t.Fatal(err)
+	}
+	trn.Mutate(TournamentMatchEnded{Match: 0, Winner: "1"})
+	if err := trn.RecordInfraction(0, "2", PenaltyDisqualification, "cheating", "j"); err != nil {
+		t.Fatal(err)
+	}
+	if !trn.isDropped("2") {
+		t.Errorf("want: disqualified player dropped")
+	}
+	if m := trn.Matches[0]; m.Bye || m.Winner != "1" {
+		t.Errorf("want: match kept for 1, got: %v", m)
+	}
+	infs := trn.infractionsOf("2")
+	if len(trn.Infractions) != 2 || len(infs) != 1 || infs[0].Penalty != PenaltyDisqualification || infs[0].Judge != "j" {
+		t.Errorf("want: two recorded infractions, got: %v", trn.Infractions)
+	}
+}
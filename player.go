@@ -19,6 +19,7 @@ type Player struct {
 	Tracker     TrackerID      `json:"tracker"`
 	Mail        string         `json:"mail"`
 	Password    string         `json:"password"`
+	ClaimCode   string         `json:"-"`
 	*event.ChangeRecorder
 	Server *Server
 }
@@ -72,6 +73,17 @@ func (s *Server) handleGETPlayer(w http.ResponseWriter, r *http.Request) {
 	}
 
 	res := plr.MakeDetailedHyperItem(resolve)
+	if plr.Role == "guest" && plr.ClaimCode != "" {
+		accID, err := s.getAccountID(r)
+		if err == nil && s.checkOrganizerPermissions(accID, "Unable to view Claim Link: Insufficient Permissions") == nil {
+			res.AddProperty(hyper.Property{
+				Label: "Claim Link",
+				Name:  "claimLink",
+				Value: resolve("../signup?guest=%s&code=%s", plr.ID, plr.ClaimCode).String(),
+			})
+		}
+	}
+	res.AddItem(s.MakeInfractionsHyperItem(resolve, plr))
 	if strings.Contains(r.Header.Get("Accept"), "text/html") {
 		err = templ.ExecuteTemplate(w, "player.html", res)
 		if err != nil {
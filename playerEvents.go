@@ -40,6 +40,22 @@ type PlayerTournamentRegistered struct {
 	Tournament TournamentID `json:"Tournament"`
 }
 
+type PlayerClaimCodeIssued struct {
+	ID         string    `json:"id"`
+	OccurredOn time.Time `json:"occurred-on"`
+	Player     PlayerID  `json:"player"`
+	Code       string    `json:"code"`
+}
+
+type PlayerClaimed struct {
+	ID         string    `json:"id"`
+	OccurredOn time.Time `json:"occurred-on"`
+	Player     PlayerID  `json:"player"`
+	Mail       string    `json:"mail"`
+	Password   string    `json:"password"`
+	Role       string    `json:"role"`
+}
+
 func NewPlayer(s *Server) *Player {
 	return &Player{
 		Server:         s,
@@ -154,6 +170,13 @@ func (plr *Player) Mutate(e event.Event) {
 		plr.Role = e.Role
 	case PlayerTournamentRegistered:
 		plr.Tournaments = append(plr.Tournaments, e.Tournament)
+	case PlayerClaimCodeIssued:
+		plr.ClaimCode = e.Code
+	case PlayerClaimed:
+		plr.Mail = e.Mail
+		plr.Password = e.Password
+		plr.Role = e.Role
+		plr.ClaimCode = ""
 	}
 }
 
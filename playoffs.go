@@ -0,0 +1,130 @@
+package tournaments
+
+const (
+	BracketSingle     = "single"
+	BracketWinners    = "winners"
+	BracketLosers     = "losers"
+	BracketGrandFinal = "grand-final"
+)
+
+// bracketOrder returns the seeds of a bracket of size n in the order they are
+// paired in the first bracket round, so that seed 1 and seed 2 can only meet in the final.
+func bracketOrder(n int) []int {
+	if n <= 1 {
+		return []int{1}
+	}
+	res := []int{}
+	for _, s := range bracketOrder(n / 2) {
+		res = append(res, s, n+1-s)
+	}
+	return res
+}
+
+func bracketRoundsFor(n int) int {
+	rounds := 0
+	for size := 1; size < n; size *= 2 {
+		rounds++
+	}
+	return rounds
+}
+
+// bracketSize returns the number of players a bracket for n players is padded to.
+func bracketSize(n int) int {
+	return 1 << uint(bracketRoundsFor(n))
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+//call on TournamentPlayoffsCreated
+func (trn *Tournament) MakePlayoffMatches() {
+	trn.makeFirstBracketRound(BracketSingle, true)
+}
+
+// makeFirstBracketRound pairs the seeds in a bracket padded to the next power of two.
+// The top seeds face the missing seeds and advance with a bye.
+func (trn *Tournament) makeFirstBracketRound(bracket string, playoff bool) {
+	order := bracketOrder(bracketSize(len(trn.Seeds)))
+	for slot := 0; slot+1 < len(order); slot += 2 {
+		hi := trn.Seeds[order[slot]-1]
+		if order[slot+1] > len(trn.Seeds) {
+			trn.Matches = append(trn.Matches, Match{
+				Player1:      hi,
+				Winner:       hi,
+				Bracket:      bracket,
+				BracketRound: 1,
+				Slot:         slot / 2,
+				Playoff:      playoff,
+				Bye:          true,
+				Ended:        true,
+			})
+			continue
+		}
+		lo := trn.Seeds[order[slot+1]-1]
+		trn.Matches = append(trn.Matches, Match{
+			Player1:      hi,
+			Player2:      lo,
+			Bracket:      bracket,
+			BracketRound: 1,
+			Slot:         slot / 2,
+			Playoff:      playoff,
+			Games:        []Game{{}},
+		})
+	}
+}
+
+// seedOf returns the 1-based playoff seed of pID, or 0 if pID is not seeded.
+func (trn *Tournament) seedOf(pID PlayerID) int {
+	for i, p := range trn.Seeds {
+		if p == pID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// bracketMatch returns the index of the match in the given bracket position, or -1.
+func (trn *Tournament) bracketMatch(bracket string, round int, slot int) int {
+	for i, m := range trn.Matches {
+		if m.Bracket == bracket && m.BracketRound == round && m.Slot == slot {
+			return i
+		}
+	}
+	return -1
+}
+
+func loserOf(m Match) PlayerID {
+	if m.Winner == m.Player1 {
+		return m.Player2
+	}
+	return m.Player1
+}
+
+// brackets returns the names of all brackets in matches in order of appearance.
+func brackets(matches []Match) []string {
+	res := []string{}
+	seen := map[string]bool{}
+	for _, m := range matches {
+		if m.Bracket != "" && !seen[m.Bracket] {
+			seen[m.Bracket] = true
+			res = append(res, m.Bracket)
+		}
+	}
+	return res
+}
+
+// bracketRounds groups the indices of all matches in bracket by their bracket round.
+func bracketRounds(matches []Match, bracket string) [][]int {
+	res := [][]int{}
+	for i, m := range matches {
+		if m.Bracket != bracket {
+			continue
+		}
+		for len(res) < m.BracketRound {
+			res = append(res, []int{})
+		}
+		res[m.BracketRound-1] = append(res[m.BracketRound-1], i)
+	}
+	return res
+}
@@ -0,0 +1,45 @@
+package tournaments
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBracketOrder(t *testing.T) {
+	want := []int{1, 8, 4, 5, 2, 7, 3, 6}
+	got := bracketOrder(8)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want: %v, got %v", want, got)
+	}
+}
+
+func TestMakePlayoffMatches(t *testing.T) {
+	trn := Tournament{}
+	trn.Mutate(TournamentPlayoffsCreated{Seeds: []PlayerID{"a", "b", "c", "d"}})
+	if len(trn.Matches) != 2 {
+		t.Fatalf("want: %d matches, got %d", 2, len(trn.Matches))
+	}
+	if trn.Matches[0].Player1 != "a" || trn.Matches[0].Player2 != "d" {
+		t.Errorf("want: a VS d, got %v", trn.Matches[0])
+	}
+	if trn.Matches[1].Player1 != "b" || trn.Matches[1].Player2 != "c" || trn.Matches[1].Slot != 1 {
+		t.Errorf("want: b VS c in slot 1, got %v", trn.Matches[1])
+	}
+	if bracketRoundsFor(4) != 2 {
+		t.Errorf("want: %d rounds, got %d", 2, bracketRoundsFor(4))
+	}
+}
+
+func TestMakePlayoffMatchesWithByes(t *testing.T) {
+	trn := Tournament{}
+	trn.Mutate(TournamentPlayoffsCreated{Seeds: []PlayerID{"a", "b", "c"}})
+	if len(trn.Matches) != 2 {
+		t.Fatalf("want: %d matches, got %d", 2, len(trn.Matches))
+	}
+	if m := trn.Matches[0]; !m.Bye || !m.Ended || m.Winner != "a" {
+		t.Errorf("want: bye for a, got %v", m)
+	}
+	if m := trn.Matches[1]; m.Player1 != "b" || m.Player2 != "c" {
+		t.Errorf("want: b VS c, got %v", m)
+	}
+}
@@ -0,0 +1,168 @@
+package tournaments
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Pod is a multiplayer match of a round, e.g. a Commander game of four players.
+type Pod struct {
+	Round      int        `json:"round"`
+	Players    []PlayerID `json:"players"`
+	Placements []PlayerID `json:"placements,omitempty"`
+	Ended      bool       `json:"ended"`
+}
+
+// placementPoints returns the points for finishing in the given 0-based place of a pod of size n.
+// Without configured placement points, the last place gets no points and every place above it one more.
+func (trn *Tournament) placementPoints(place int, n int) int {
+	if trn.PlacementPoints == nil {
+		return n - 1 - place
+	}
+	if place < len(trn.PlacementPoints) {
+		return trn.PlacementPoints[place]
+	}
+	return 0
+}
+
+func (trn *Tournament) podPoints(pID PlayerID) int {
+	points := 0
+	for _, pod := range trn.Pods {
+		for place, p := range pod.Placements {
+			if p == pID {
+				points += trn.placementPoints(place, len(pod.Players))
+			}
+		}
+	}
+	return points
+}
+
+// podSizes splits n players into as few pods of at most size players as possible,
+// with pod sizes differing by at most one. No pod has fewer than 3 players, so where
+// that is not possible otherwise, pods grow beyond size instead.
+func podSizes(n int, size int) []int {
+	if n == 0 {
+		return nil
+	}
+	k := (n + size - 1) / size
+	if k > n/3 {
+		k = n / 3
+	}
+	if k == 0 {
+		return []int{n}
+	}
+	res := []int{}
+	for i := 0; i < k; i++ {
+		s := n / k
+		if i < n%k {
+			s++
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+// havePodded returns the number of pods a and b have shared so far.
+func (trn *Tournament) havePodded(a, b PlayerID) int {
+	count := 0
+	for _, pod := range trn.Pods {
+		if containsPlayer(pod.Players, a) && containsPlayer(pod.Players, b) {
+			count++
+		}
+	}
+	return count
+}
+
+//call on TournamentMatchesCreated
+func (trn *Tournament) MakePods(round int, eventTime time.Time) {
+	plrs := trn.activePlayers()
+	r := rand.New(rand.NewSource(eventTime.Unix()))
+	r.Shuffle(len(plrs), func(i, j int) {
+		plrs[i], plrs[j] = plrs[j], plrs[i]
+	})
+	points := map[PlayerID]int{}
+	for _, p := range plrs {
+		points[p] = trn.matchPoints(p)
+	}
+	sort.SliceStable(plrs, func(i, j int) bool {
+		return points[plrs[i]] > points[plrs[j]]
+	})
+	for _, size := range podSizes(len(plrs), trn.PodSize) {
+		pod := []PlayerID{plrs[0]}
+		plrs = plrs[1:]
+		for len(pod) < size {
+			// the highest ranked player who has met the fewest of the pod before
+			best, fewest := 0, -1
+			for i, p := range plrs {
+				met := 0
+				for _, q := range pod {
+					met += trn.havePodded(p, q)
+				}
+				if fewest < 0 || met < fewest {
+					best, fewest = i, met
+				}
+			}
+			pod = append(pod, plrs[best])
+			plrs = append(plrs[:best], plrs[best+1:]...)
+		}
+		trn.Pods = append(trn.Pods, Pod{Round: round, Players: pod})
+	}
+}
+
+//call on TournamentPodEnded
+func (trn *Tournament) managePodResult(pod int) {
+	p := trn.Pods[pod]
+	for place, pID := range p.Placements {
+		part := trn.getParticipantByID(pID)
+		if part == nil {
+			continue
+		}
+		part.Matches++
+		part.Games++
+		if place == 0 {
+			part.MatchWins++
+			part.GameWins++
+		}
+	}
+}
+
+// isPlacementValid reports whether placements lists every player of the pod exactly once.
+func isPlacementValid(pod Pod, placements []PlayerID) bool {
+	if len(placements) != len(pod.Players) {
+		return false
+	}
+	for i, p := range placements {
+		if !containsPlayer(pod.Players, p) || containsPlayer(placements[:i], p) {
+			return false
+		}
+	}
+	return true
+}
+
+// parsePoints parses a comma separated list of placement points.
+func parsePoints(s string) ([]int, error) {
+	res := []int{}
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, n)
+	}
+	return res, nil
+}
+
+func formatPoints(points []int) string {
+	res := []string{}
+	for _, p := range points {
+		res = append(res, strconv.Itoa(p))
+	}
+	return strings.Join(res, ",")
+}
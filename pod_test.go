@@ -0,0 +1,77 @@
+package tournaments
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPodSizes(t *testing.T) {
+	tests := []struct {
+		n    int
+		size int
+		want []int
+	}{
+		{8, 4, []int{4, 4}},
+		{9, 4, []int{3, 3, 3}},
+		{10, 4, []int{4, 3, 3}},
+		{5, 4, []int{5}},
+		{7, 3, []int{4, 3}},
+		{11, 5, []int{4, 4, 3}},
+		{14, 6, []int{5, 5, 4}},
+		{3, 4, []int{3}},
+	}
+	for _, tt := range tests {
+		got := podSizes(tt.n, tt.size)
+		if len(got) != len(tt.want) {
+			t.Errorf("podSizes(%d, %d): want: %v, got: %v", tt.n, tt.size, tt.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("podSizes(%d, %d): want: %v, got: %v", tt.n, tt.size, tt.want, got)
+				break
+			}
+		}
+	}
+}
+
+func TestPodPlacementPoints(t *testing.T) {
+	trn := Tournament{PodSize: 4, Pairing: PairingSwiss}
+	for _, p := range []PlayerID{"1", "2", "3", "4", "5", "6", "7", "8"} {
+		trn.Participants = append(trn.Participants, Participant{Player: p})
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1, OccurredOn: time.Unix(1, 0)})
+	if len(trn.Pods) != 2 {
+		t.Fatalf("want: %d pods, got: %d", 2, len(trn.Pods))
+	}
+	trn.Mutate(TournamentPodEnded{Pod: 0, Placements: trn.Pods[0].Players})
+	first := trn.Pods[0].Players[0]
+	if trn.podPoints(first) != 3 || trn.podPoints(trn.Pods[0].Players[3]) != 0 {
+		t.Errorf("unexpected default points: %d, %d", trn.podPoints(first), trn.podPoints(trn.Pods[0].Players[3]))
+	}
+	trn.PlacementPoints = []int{5, 2}
+	if trn.podPoints(first) != 5 || trn.podPoints(trn.Pods[0].Players[2]) != 0 {
+		t.Errorf("unexpected configured points: %d, %d", trn.podPoints(first), trn.podPoints(trn.Pods[0].Players[2]))
+	}
+	if par := trn.getParticipantByID(first); par.MatchWins != 1 || par.Matches != 1 {
+		t.Errorf("want: pod win for %v, got: %v", first, *par)
+	}
+}
+
+func TestMakePodsAvoidsRepeats(t *testing.T) {
+	trn := Tournament{PodSize: 4, Pairing: PairingSwiss}
+	for _, p := range []PlayerID{"1", "2", "3", "4", "5", "6", "7", "8"} {
+		trn.Participants = append(trn.Participants, Participant{Player: p})
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1, OccurredOn: time.Unix(1, 0)})
+	trn.Mutate(TournamentMatchesCreated{Round: 2, OccurredOn: time.Unix(2, 0)})
+	for _, pod := range trn.Pods[2:] {
+		for i, a := range pod.Players {
+			for _, b := range pod.Players[i+1:] {
+				if trn.havePodded(a, b) > 2 {
+					t.Errorf("%v and %v share more than two pods", a, b)
+				}
+			}
+		}
+	}
+}
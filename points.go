@@ -0,0 +1,80 @@
+package tournaments
+
+import "fmt"
+
+const (
+	TiebreakerOpponentsMatchWin = "omw"
+	TiebreakerGameWin           = "gw"
+	TiebreakerOpponentsGameWin  = "ogw"
+)
+
+var tiebreakers = []string{TiebreakerOpponentsMatchWin, TiebreakerGameWin, TiebreakerOpponentsGameWin}
+
+// PointsSystem sets the match points awarded for every result.
+type PointsSystem struct {
+	Win  int `json:"win"`
+	Draw int `json:"draw"`
+	Loss int `json:"loss"`
+	Bye  int `json:"bye"`
+}
+
+var defaultPointsSystem = PointsSystem{Win: 3, Draw: 1, Loss: 0, Bye: 3}
+
+// pointsSystem returns the configured points system or the default 3/1/0/3.
+func (trn *Tournament) pointsSystem() PointsSystem {
+	if trn.PointsSystem == nil {
+		return defaultPointsSystem
+	}
+	return *trn.PointsSystem
+}
+
+// tiebreakerOrder returns the configured tiebreakers, or the MTR order OMW%, GW%, OGW%.
+func (trn *Tournament) tiebreakerOrder() []string {
+	if trn.Tiebreakers == nil {
+		return tiebreakers
+	}
+	return trn.Tiebreakers
+}
+
+// resultPoints returns the match points pID received for m.
+func (trn *Tournament) resultPoints(m Match, pID PlayerID) int {
+	ps := trn.pointsSystem()
+	switch {
+	case m.Bye:
+		return ps.Bye
+	case m.Draw:
+		return ps.Draw
+	case m.Winner == pID:
+		return ps.Win
+	default:
+		return ps.Loss
+	}
+}
+
+func validateTiebreakers(list []string) error {
+	if len(list) == 0 {
+		return fmt.Errorf("Tiebreakers not specified")
+	}
+	for i, tb := range list {
+		if !containsString(tiebreakers, tb) {
+			return fmt.Errorf("Tiebreaker not recognized: %s", tb)
+		}
+		if containsString(list[:i], tb) {
+			return fmt.Errorf("Tiebreaker listed twice: %s", tb)
+		}
+	}
+	return nil
+}
+
+// tiebreakerValue returns the value of the given tiebreaker of s.
+func tiebreakerValue(s Standing, tb string) float64 {
+	switch tb {
+	case TiebreakerOpponentsMatchWin:
+		return s.OpponentsMatchWinPercentage
+	case TiebreakerGameWin:
+		return s.GameWinPercentage
+	case TiebreakerOpponentsGameWin:
+		return s.OpponentsGameWinPercentage
+	}
+	return 0
+}
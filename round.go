@@ -0,0 +1,109 @@
+package tournaments
+
+import "time"
+
+type Round struct {
+	Number     int       `json:"number"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Ended      bool      `json:"ended"`
+	Deadline   time.Time `json:"deadline"`
+	TimeCalled bool      `json:"timeCalled"`
+}
+
+// RoundMatches is a Round together with all of its matches, as presented to clients.
+type RoundMatches struct {
+	Round
+	Matches []IndexedMatch `json:"matches"`
+	Pods    []IndexedPod   `json:"pods,omitempty"`
+}
+
+// IndexedMatch is a Match together with its index in Tournament.Matches,
+// which is needed to report results.
+type IndexedMatch struct {
+	Index int `json:"index"`
+	Match
+}
+
+// IndexedPod is a Pod together with its index in Tournament.Pods.
+type IndexedPod struct {
+	Index int `json:"index"`
+	Pod
+}
+
+// currentRound returns the number of the most recently started round.
+func (trn *Tournament) currentRound() int {
+	return len(trn.Rounds)
+}
+
+// runningRound returns the number of the round that has been started but not ended, or 0.
+func (trn *Tournament) runningRound() int {
+	if len(trn.Rounds) == 0 || trn.Rounds[len(trn.Rounds)-1].Ended {
+		return 0
+	}
+	return len(trn.Rounds)
+}
+
+// remainingTime returns the time left on the clock of the running round at now.
+// It is 0 if no clock has been started or the time is up.
+func (trn *Tournament) remainingTime(now time.Time) time.Duration {
+	round := trn.runningRound()
+	if round == 0 || trn.Rounds[round-1].Deadline.IsZero() {
+		return 0
+	}
+	if left := trn.Rounds[round-1].Deadline.Sub(now); left > 0 {
+		return left
+	}
+	return 0
+}
+
+//call on TournamentTimeCalled
+func (trn *Tournament) manageTimeCalled(round int) {
+	trn.Rounds[round-1].TimeCalled = true
+	for i := range trn.Matches {
+		m := &trn.Matches[i]
+		if m.Round == round && !m.Ended {
+			m.AdditionalTurns = true
+		}
+	}
+}
+
+// inCurrentRound reports whether results of a match of round may be entered. Tournaments
+// that were paired before rounds were recorded have no rounds, so all of their matches are open.
+func (trn *Tournament) inCurrentRound(round int) bool {
+	return round == 0 || len(trn.Rounds) == 0 || round == trn.runningRound()
+}
+
+func (trn *Tournament) roundsComplete() bool {
+	if len(trn.Rounds) == 0 && trn.Matches != nil && !trn.pairsPerRound() {
+		// paired before rounds were recorded
+		return trn.allMatchesEnded()
+	}
+	return trn.currentRound() >= trn.totalRounds() && trn.runningRound() == 0
+}
+
+func (trn *Tournament) roundMatches() []RoundMatches {
+	res := []RoundMatches{}
+	for i, m := range trn.Matches {
+		if m.Round == 0 {
+			continue
+		}
+		for len(res) < m.Round {
+			res = append(res, RoundMatches{Round: Round{Number: len(res) + 1}, Matches: []IndexedMatch{}})
+		}
+		res[m.Round-1].Matches = append(res[m.Round-1].Matches, IndexedMatch{Index: i, Match: m})
+	}
+	for i, p := range trn.Pods {
+		for len(res) < p.Round {
+			res = append(res, RoundMatches{Round: Round{Number: len(res) + 1}, Matches: []IndexedMatch{}})
+		}
+		res[p.Round-1].Pods = append(res[p.Round-1].Pods, IndexedPod{Index: i, Pod: p})
+	}
+	for i, r := range trn.Rounds {
+		for len(res) <= i {
+			res = append(res, RoundMatches{Round: Round{Number: len(res) + 1}, Matches: []IndexedMatch{}})
+		}
+		res[i].Round = r
+	}
+	return res
+}
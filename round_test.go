@@ -0,0 +1,165 @@
+package tournaments
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRoundLifecycle(t *testing.T) {
+	trn := Tournament{
+		Pairing:      PairingSwiss,
+		GamesToWin:   1,
+		Participants: []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}},
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	trn.Mutate(TournamentRoundStarted{Round: 1})
+	if trn.runningRound() != 1 {
+		t.Fatalf("want: running round %d, got %d", 1, trn.runningRound())
+	}
+	for i, m := range trn.Matches {
+		trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: m.Player1})
+		trn.Mutate(TournamentMatchEnded{Match: i, Winner: m.Player1})
+	}
+	trn.Mutate(TournamentRoundEnded{Round: 1})
+	if trn.runningRound() != 0 {
+		t.Errorf("want: no running round, got %d", trn.runningRound())
+	}
+	rms := trn.roundMatches()
+	if len(rms) != 1 || len(rms[0].Matches) != 2 || !rms[0].Ended {
+		t.Errorf("unexpected rounds: %v", rms)
+	}
+	if trn.roundsComplete() {
+		t.Errorf("want: rounds not complete after round %d of %d", 1, trn.totalRounds())
+	}
+}
+
+func TestRoundRobinByes(t *testing.T) {
+	trn := Tournament{
+		Pairing:      PairingRoundRobin,
+		GamesToWin:   2,
+		Participants: []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}},
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	if len(trn.Matches) != 3 {
+		t.Fatalf("want: %d matches, got: %d", 3, len(trn.Matches))
+	}
+	for r := 1; r <= trn.totalRounds(); r++ {
+		unpaired := trn.unpairedPlayers(r)
+		if len(unpaired) != 1 {
+			t.Fatalf("round %d: want: one unpaired player, got: %v", r, unpaired)
+		}
+		trn.Mutate(TournamentByeAwarded{Round: r, Player: unpaired[0]})
+		if !trn.hadBye(unpaired[0]) {
+			t.Errorf("round %d: want: bye for %v", r, unpaired[0])
+		}
+	}
+	for _, par := range trn.Participants {
+		if par.Matches != 1 || par.MatchWins != 1 || par.GameWins != byeGameWins {
+			t.Errorf("want: a 2-0 bye for %v, got: %v", par.Player, par)
+		}
+		if trn.matchPoints(par.Player) != 3 {
+			t.Errorf("want: %d points for %v, got: %d", 3, par.Player, trn.matchPoints(par.Player))
+		}
+	}
+}
+
+func TestRoundRobinByesPerRound(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Phase = PhaseRounds
+	trn.Pairing = PairingRoundRobin
+	trn.GamesToWin = 1
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}}
+	for r := 1; r <= 2; r++ {
+		if err := trn.StartRound(); err != nil {
+			t.Fatal(err)
+		}
+		byes := 0
+		for _, m := range trn.Matches {
+			if m.Bye {
+				byes++
+				if m.Round > r {
+					t.Errorf("round %d: want: no bye for later round %d", r, m.Round)
+				}
+			}
+		}
+		if byes != r {
+			t.Errorf("round %d: want: %d byes, got: %d", r, r, byes)
+		}
+		for i, m := range trn.Matches {
+			if m.Round == r && !m.Ended {
+				trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: m.Player1})
+				trn.Mutate(TournamentMatchEnded{Match: i, Winner: m.Player1})
+			}
+		}
+		trn.Mutate(TournamentRoundEnded{Round: r})
+	}
+}
+
+func TestRoundClock(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Phase = PhaseRounds
+	trn.Pairing = PairingSwiss
+	trn.GamesToWin = 2
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	trn.Mutate(TournamentRoundStarted{Round: 1})
+	if err := trn.StartClock(); err == nil {
+		t.Errorf("want: error for starting the clock without round time")
+	}
+	trn.Mutate(TournamentRoundTimeChanged{RoundTime: 50})
+	if err := trn.StartClock(); err != nil {
+		t.Fatal(err)
+	}
+	if left := trn.remainingTime(time.Now().UTC()); left <= 49*time.Minute || left > 50*time.Minute {
+		t.Errorf("want: about 50 minutes left, got: %v", left)
+	}
+	if err := trn.CallTime(); err == nil {
+		t.Errorf("want: error for calling time before it is up")
+	}
+	trn.Rounds[0].Deadline = time.Now().UTC().Add(-time.Minute)
+	if trn.remainingTime(time.Now().UTC()) != 0 {
+		t.Errorf("want: no time left")
+	}
+	trn.Mutate(TournamentGameEnded{Match: 0, Game: 0, Winner: trn.Matches[0].Player1})
+	trn.Mutate(TournamentGameEnded{Match: 0, Game: 1, Winner: trn.Matches[0].Player1})
+	trn.Mutate(TournamentMatchEnded{Match: 0, Winner: trn.Matches[0].Player1})
+	if err := trn.CallTime(); err != nil {
+		t.Fatal(err)
+	}
+	if !trn.Rounds[0].TimeCalled || trn.Matches[0].AdditionalTurns || !trn.Matches[1].AdditionalTurns {
+		t.Errorf("want: only the unfinished match in additional turns, got: %v", trn.Matches)
+	}
+	if err := trn.ConcludeMatch(0, true); err == nil {
+		t.Errorf("want: error for concluding an ended match")
+	}
+}
+
+func TestRoundsWithoutRoundEvents(t *testing.T) {
+	// streams recorded before rounds existed pair all matches without starting a round
+	trn := Tournament{
+		Pairing:      PairingRoundRobin,
+		GamesToWin:   1,
+		Participants: []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}},
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	for i, m := range trn.Matches {
+		if err := trn.checkMatchOpen(i); err != nil {
+			t.Fatalf("match %d of round %d: %v", i, m.Round, err)
+		}
+		if err := trn.checkGameOpen(i, 0); err != nil {
+			t.Fatalf("match %d of round %d: %v", i, m.Round, err)
+		}
+	}
+	if trn.roundsComplete() {
+		t.Errorf("want: rounds not complete with open matches")
+	}
+	for i, m := range trn.Matches {
+		trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: m.Player1})
+		trn.Mutate(TournamentMatchEnded{Match: i, Winner: m.Player1})
+	}
+	if !trn.roundsComplete() {
+		t.Errorf("want: rounds complete once all matches have ended")
+	}
+}
@@ -0,0 +1,97 @@
+package tournaments
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// schedulerInterval is how often the scheduler looks for due phase changes.
+const schedulerInterval = time.Minute
+
+// Schedule holds the times at which the phases of a tournament advance automatically.
+// Zero times are not scheduled.
+type Schedule struct {
+	RegistrationOpen  time.Time `json:"registrationOpen"`
+	RegistrationClose time.Time `json:"registrationClose"`
+	Start             time.Time `json:"start"`
+}
+
+func due(t time.Time, now time.Time) bool {
+	return !t.IsZero() && !now.Before(t)
+}
+
+// advanceSchedule ends every phase whose scheduled end has passed at now:
+// initialization when registration opens, registration when it closes and
+// registration and check-in when the tournament starts. Later phases, e.g.
+// the draft, are left to the organizer.
+func (trn *Tournament) advanceSchedule(now time.Time) error {
+	s := trn.Schedule
+	if trn.Phase == PhaseInitialization && due(s.RegistrationOpen, now) {
+		err := trn.EndPhase()
+		if err != nil {
+			return err
+		}
+	}
+	if trn.Phase == PhaseRegistration && due(s.RegistrationClose, now) {
+		err := trn.EndPhase()
+		if err != nil {
+			return err
+		}
+	}
+	for due(s.Start, now) && (trn.Phase == PhaseRegistration || trn.Phase == PhaseCheckIn) {
+		err := trn.EndPhase()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunScheduler advances the phases of all tournaments according to their schedule
+// until ctx is done.
+func (s *Server) RunScheduler(ctx context.Context) {
+	ticker := time.NewTicker(schedulerInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.advanceSchedules(now.UTC())
+		}
+	}
+}
+
+func (s *Server) advanceSchedules(now time.Time) {
+	trns, err := s.p.FindAllTournaments()
+	if err != nil {
+		log.Println("Scheduler:", err)
+		return
+	}
+	for _, t := range trns {
+		// deleted tournaments fail to load and are skipped like ended ones
+		trn, err := LoadTournament(s, t.ID)
+		if err != nil || trn.Phase == PhaseEnded || trn.Schedule == (Schedule{}) {
+			continue
+		}
+		err = trn.advanceSchedule(now)
+		if err != nil {
+			// phases that did advance before the failure are discarded as well
+			log.Printf("Scheduler: Tournament %v: %v\n", trn.ID, err)
+			continue
+		}
+		err = trn.Save(s.es, nil)
+		if err != nil {
+			log.Printf("Scheduler: Tournament %v: %v\n", trn.ID, err)
+		}
+	}
+}
+
+// scheduledTime formats t as a datetime-local value in server time, or empty if unscheduled.
+func scheduledTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(time.Local).Format(dateTimeLocal)
+}
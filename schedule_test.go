@@ -0,0 +1,72 @@
+package tournaments
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSchedule(t *testing.T) {
+	now := time.Now().UTC()
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Name = "Scheduled"
+	trn.Format = FormatConstructed
+	trn.Phase = PhaseInitialization
+	trn.GamesToWin = 2
+	trn.MaxPlayers = 8
+	err := trn.ChangeSchedule(Schedule{RegistrationOpen: now, RegistrationClose: now.Add(-time.Hour)})
+	if err == nil {
+		t.Errorf("want: error for registration closing before it opens")
+	}
+	err = trn.ChangeSchedule(Schedule{RegistrationOpen: now.Add(time.Hour), RegistrationClose: now.Add(2 * time.Hour), Start: now.Add(3 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := trn.advanceSchedule(now); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Phase != PhaseInitialization {
+		t.Errorf("want: phase %s before registration opens, got: %s", PhaseInitialization, trn.Phase)
+	}
+	if err := trn.advanceSchedule(now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Phase != PhaseRegistration {
+		t.Errorf("want: phase %s once registration opens, got: %s", PhaseRegistration, trn.Phase)
+	}
+}
+
+func TestScheduledStartStopsAtDraft(t *testing.T) {
+	now := time.Now().UTC()
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Format = FormatCube
+	trn.Phase = PhaseCheckIn
+	trn.GamesToWin = 2
+	trn.MaxPlayers = 8
+	trn.Participants = []Participant{{Player: "1", CheckedIn: true}, {Player: "2", CheckedIn: true}}
+	trn.Schedule = Schedule{Start: now}
+	if err := trn.advanceSchedule(now); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Phase != PhaseDraft {
+		t.Errorf("want: phase %s after the scheduled start, got: %s", PhaseDraft, trn.Phase)
+	}
+}
+
+func TestRunSchedulerStops(t *testing.T) {
+	s := &Server{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.RunScheduler(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("want: scheduler stopped when its context is done")
+	}
+}
@@ -0,0 +1,58 @@
+package tournaments
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// parseCardList splits a card list with one card per line into card names.
+func parseCardList(s string) []string {
+	res := []string{}
+	for _, line := range strings.Split(s, "\n") {
+		card := strings.TrimSpace(line)
+		if card != "" {
+			res = append(res, card)
+		}
+	}
+	return res
+}
+
+//call on TournamentPoolsOpened
+func (trn *Tournament) dealPools(seed int64) {
+	r := rand.New(rand.NewSource(seed))
+	stack := []string{}
+	for i := range trn.Participants {
+		pool := []string{}
+		for len(pool) < trn.PoolSize {
+			// once the card list is used up a fresh copy is shuffled, like opening another box of a set
+			if len(stack) == 0 {
+				stack = make([]string, len(trn.CardList))
+				copy(stack, trn.CardList)
+				r.Shuffle(len(stack), func(i, j int) {
+					stack[i], stack[j] = stack[j], stack[i]
+				})
+			}
+			pool = append(pool, stack[0])
+			stack = stack[1:]
+		}
+		sort.Strings(pool)
+		trn.Participants[i].Pool = pool
+	}
+}
+
+// containsCards reports whether every card of cards can be taken from pool,
+// respecting the number of copies in pool.
+func containsCards(pool []string, cards []string) bool {
+	count := map[string]int{}
+	for _, c := range pool {
+		count[c]++
+	}
+	for _, c := range cards {
+		if count[c] == 0 {
+			return false
+		}
+		count[c]--
+	}
+	return true
+}
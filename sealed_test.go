@@ -0,0 +1,41 @@
+package tournaments
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDealPools(t *testing.T) {
+	newTrn := func() *Tournament {
+		return &Tournament{
+			CardList:     []string{"a", "b", "c", "d", "e"},
+			PoolSize:     3,
+			Participants: []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}},
+		}
+	}
+	trn := newTrn()
+	trn.Mutate(TournamentPoolsOpened{Seed: 42})
+	for _, par := range trn.Participants {
+		if len(par.Pool) != 3 {
+			t.Errorf("want: pool of %d cards, got: %v", 3, par.Pool)
+		}
+	}
+	replay := newTrn()
+	replay.Mutate(TournamentPoolsOpened{Seed: 42})
+	if !reflect.DeepEqual(trn.Participants, replay.Participants) {
+		t.Errorf("pools not reproducible: want %v, got %v", trn.Participants, replay.Participants)
+	}
+}
+
+func TestContainsCards(t *testing.T) {
+	pool := []string{"a", "a", "b"}
+	if !containsCards(pool, []string{"a", "b", "a"}) {
+		t.Errorf("want: deck to be contained in pool")
+	}
+	if containsCards(pool, []string{"a", "a", "a"}) {
+		t.Errorf("want: third copy to be rejected")
+	}
+	if containsCards(pool, []string{"c"}) {
+		t.Errorf("want: card outside of pool to be rejected")
+	}
+}
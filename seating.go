@@ -0,0 +1,142 @@
+package tournaments
+
+import "sort"
+
+// Drafts of more than draftPodMaxSize players are split into several draft pods,
+// whose players are paired among each other for the first draftPodRounds rounds.
+const (
+	draftPodMaxSize = 8
+	draftPodRounds  = 3
+)
+
+const (
+	SeatPairingCross    = "cross"
+	SeatPairingNeighbor = "neighbor"
+	SeatPairingRandom   = "random"
+)
+
+var seatPairings = []string{SeatPairingCross, SeatPairingNeighbor, SeatPairingRandom}
+
+func isSeatPairingValid(p string) bool {
+	return containsString(seatPairings, p)
+}
+
+// seatPairing returns the configured first round pairing. Cross-pairing is the default.
+func (trn *Tournament) seatPairing() string {
+	if trn.SeatPairing == "" {
+		return SeatPairingCross
+	}
+	return trn.SeatPairing
+}
+
+// seated reports whether the participants have been assigned distinct seats, i.e. in a draft.
+func (trn *Tournament) seated() bool {
+	if len(trn.Participants) < 2 {
+		return false
+	}
+	seen := map[int]bool{}
+	for _, par := range trn.Participants {
+		if seen[par.SeatIndex] {
+			return false
+		}
+		seen[par.SeatIndex] = true
+	}
+	return true
+}
+
+// draftPodSizes returns the sizes of the draft pods for n players.
+func draftPodSizes(n int) []int {
+	if n <= draftPodMaxSize {
+		return []int{n}
+	}
+	return podSizes(n, draftPodMaxSize)
+}
+
+//call on TournamentPhaseChanged
+func (trn *Tournament) assignDraftPods() {
+	sizes := draftPodSizes(len(trn.Participants))
+	for i := range trn.Participants {
+		seat := trn.Participants[i].SeatIndex
+		pod := 0
+		for pod < len(sizes)-1 && seat >= sizes[pod] {
+			seat -= sizes[pod]
+			pod++
+		}
+		trn.Participants[i].DraftPod = pod
+	}
+}
+
+// draftPodSeats returns the seat indices of every draft pod in seating order.
+func (trn *Tournament) draftPodSeats() [][]int {
+	res := [][]int{}
+	start := 0
+	for _, size := range draftPodSizes(len(trn.Participants)) {
+		seats := []int{}
+		for i := start; i < start+size; i++ {
+			seats = append(seats, i)
+		}
+		res = append(res, seats)
+		start += size
+	}
+	return res
+}
+
+// pairsInDraftPods reports whether the given round is paired within the draft pods.
+func (trn *Tournament) pairsInDraftPods(round int) bool {
+	return trn.seated() && len(draftPodSizes(len(trn.Participants))) > 1 && round <= draftPodRounds
+}
+
+// draftPodGroups splits plrs into their draft pods, keeping their order.
+func (trn *Tournament) draftPodGroups(plrs []PlayerID) [][]PlayerID {
+	res := make([][]PlayerID, len(draftPodSizes(len(trn.Participants))))
+	for _, p := range plrs {
+		pod := trn.getParticipantByID(p).DraftPod
+		res[pod] = append(res[pod], p)
+	}
+	return res
+}
+
+// seatPairs returns the first round pairings derived from the draft seats with the given
+// seat pairing, or nil if players are not seated or no or random pairing is given. Players are paired within
+// their draft pod; players left over in pods of odd size are paired among each other.
+// With an odd number of players the last one is paired with "" and receives a bye.
+func (trn *Tournament) seatPairs(seatPairing string) [][2]PlayerID {
+	if !trn.seated() || seatPairing == "" || seatPairing == SeatPairingRandom {
+		return nil
+	}
+	parts := make([]Participant, len(trn.Participants))
+	copy(parts, trn.Participants)
+	sort.SliceStable(parts, func(i, j int) bool {
+		return parts[i].SeatIndex < parts[j].SeatIndex
+	})
+	seats := []PlayerID{}
+	for _, par := range parts {
+		if !par.Dropped {
+			seats = append(seats, par.Player)
+		}
+	}
+	res := [][2]PlayerID{}
+	leftovers := []PlayerID{}
+	for _, pod := range trn.draftPodGroups(seats) {
+		if len(pod)%2 != 0 {
+			leftovers = append(leftovers, pod[len(pod)-1])
+			pod = pod[:len(pod)-1]
+		}
+		half := len(pod) / 2
+		for i := 0; i < half; i++ {
+			if seatPairing == SeatPairingNeighbor {
+				res = append(res, [2]PlayerID{pod[2*i], pod[2*i+1]})
+			} else {
+				res = append(res, [2]PlayerID{pod[i], pod[i+half]})
+			}
+		}
+	}
+	for i := 0; i < len(leftovers); i += 2 {
+		if i+1 < len(leftovers) {
+			res = append(res, [2]PlayerID{leftovers[i], leftovers[i+1]})
+		} else {
+			res = append(res, [2]PlayerID{leftovers[i], ""})
+		}
+	}
+	return res
+}
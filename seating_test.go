@@ -0,0 +1,114 @@
+package tournaments
+
+import "testing"
+
+func TestSeatPairs(t *testing.T) {
+	trn := Tournament{}
+	for i, p := range []PlayerID{"a", "b", "c", "d", "e", "f"} {
+		trn.Participants = append(trn.Participants, Participant{Player: p, SeatIndex: i})
+	}
+	tests := []struct {
+		pairing string
+		want    [][2]PlayerID
+	}{
+		{SeatPairingCross, [][2]PlayerID{{"a", "d"}, {"b", "e"}, {"c", "f"}}},
+		{SeatPairingNeighbor, [][2]PlayerID{{"a", "b"}, {"c", "d"}, {"e", "f"}}},
+		{SeatPairingRandom, nil},
+	}
+	for _, tt := range tests {
+		got := trn.seatPairs(tt.pairing)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: want: %v, got: %v", tt.pairing, tt.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: want: %v, got: %v", tt.pairing, tt.want, got)
+				break
+			}
+		}
+	}
+}
+
+func TestFirstRoundCrossPairing(t *testing.T) {
+	for _, pairing := range []string{PairingRoundRobin, PairingSwiss} {
+		trn := Tournament{Pairing: pairing}
+		for i, p := range []PlayerID{"a", "b", "c", "d", "e"} {
+			trn.Participants = append(trn.Participants, Participant{Player: p, SeatIndex: i})
+		}
+		trn.Mutate(TournamentMatchesCreated{Round: 1, SeatPairing: SeatPairingCross})
+		want := map[PlayerID]PlayerID{"a": "c", "b": "d"}
+		n := 0
+		for _, m := range trn.Matches {
+			if m.Round != 1 {
+				continue
+			}
+			n++
+			if want[m.Player1] != m.Player2 && want[m.Player2] != m.Player1 {
+				t.Errorf("%s: unexpected first round match %v VS %v", pairing, m.Player1, m.Player2)
+			}
+		}
+		if n != 2 {
+			t.Errorf("%s: want: %d first round matches, got: %d", pairing, 2, n)
+		}
+		if u := trn.unpairedPlayers(1); len(u) != 1 || u[0] != "e" {
+			t.Errorf("%s: want: e unpaired, got: %v", pairing, u)
+		}
+	}
+}
+
+func TestReplayWithoutSeatPairing(t *testing.T) {
+	// matches created before seat pairings were recorded keep their original order
+	trn := Tournament{Pairing: PairingRoundRobin, SeatPairing: SeatPairingNeighbor}
+	for i, p := range []PlayerID{"a", "b", "c", "d"} {
+		trn.Participants = append(trn.Participants, Participant{Player: p, SeatIndex: 3 - i})
+	}
+	want := trn
+	want.MakeMatches()
+	trn.Mutate(TournamentMatchesCreated{})
+	if len(trn.Matches) != len(want.Matches) {
+		t.Fatalf("want: %d matches, got: %d", len(want.Matches), len(trn.Matches))
+	}
+	for i, m := range trn.Matches {
+		if m.Player1 != want.Matches[i].Player1 || m.Player2 != want.Matches[i].Player2 {
+			t.Errorf("match %d: want: %v VS %v, got: %v VS %v", i, want.Matches[i].Player1, want.Matches[i].Player2, m.Player1, m.Player2)
+		}
+	}
+}
+
+func TestDraftPods(t *testing.T) {
+	trn := Tournament{Pairing: PairingSwiss}
+	for i := 0; i < 14; i++ {
+		trn.Participants = append(trn.Participants, Participant{Player: PlayerID(string(rune('a' + i))), SeatIndex: i})
+	}
+	trn.assignDraftPods()
+	for _, par := range trn.Participants {
+		want := 0
+		if par.SeatIndex >= 7 {
+			want = 1
+		}
+		if par.DraftPod != want {
+			t.Errorf("seat %d: want: pod %d, got: %d", par.SeatIndex, want, par.DraftPod)
+		}
+	}
+	for round := 1; round <= draftPodRounds+1; round++ {
+		trn.Mutate(TournamentMatchesCreated{Round: round})
+		for _, pID := range trn.unpairedPlayers(round) {
+			trn.Mutate(TournamentByeAwarded{Round: round, Player: pID})
+		}
+		across := 0
+		for i, m := range trn.Matches {
+			if m.Round != round || m.Bye {
+				continue
+			}
+			if trn.getParticipantByID(m.Player1).DraftPod != trn.getParticipantByID(m.Player2).DraftPod {
+				across++
+			}
+			trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: m.Player1})
+			trn.Mutate(TournamentMatchEnded{Match: i, Winner: m.Player1})
+		}
+		if round <= draftPodRounds && across > 1 {
+			t.Errorf("round %d: want: at most one match across pods, got: %d", round, across)
+		}
+	}
+}
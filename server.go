@@ -46,8 +46,11 @@ func (s *Server) init() {
 		"propertyByName":      propertyByName,
 		"itemByType":          itemByType,
 		"wins":                wins,
-		"getParticipants":     getParticipants,
-		"sortParticipants":    sortParticipants,
+		"percentage":          percentage,
+		"brackets":            brackets,
+		"bracketRounds":       bracketRounds,
+		"seedByID":            seedByID,
+		"teamNameByID":        teamNameByID,
 	}
 	templ = template.Must(template.New("server").Funcs(funcMap).ParseGlob("assets/templates/*.html"))
 
@@ -115,6 +118,8 @@ func (s *Server) init() {
 
 	s.router.Route("/api/trackers/:id").GET(aChain.ThenFunc(s.HandleGETTracker))
 
+	s.router.Route("/api/teams/:id").GET(aChain.ThenFunc(s.handleGETTeam))
+
 	s.router.Route("/api/decks/").GET(aChain.ThenFunc(s.handleGETDecks))
 	s.router.Route("/api/decks/:id").GET(aChain.ThenFunc(s.handleGetDeck))
 	s.router.Route("/api/decks/").POST(aChain.ThenFunc(s.handlePOSTDecks))
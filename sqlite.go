@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/cognicraft/event"
 	"github.com/cognicraft/sqlutil"
@@ -38,7 +39,24 @@ func (s *Store) init() error {
 	if err != nil {
 		return err
 	}
-	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS participants (tournament TEXT, player TEXT, seat_index INTEGER, deck TEXT, matches INTEGER, games INTEGER, match_wins INTEGER, game_wins INTEGER, PRIMARY KEY (tournament, player));`)
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS participants (tournament TEXT, player TEXT, seat_index INTEGER, deck TEXT, matches INTEGER, games INTEGER, match_wins INTEGER, game_wins INTEGER, dropped INTEGER NOT NULL DEFAULT 0, PRIMARY KEY (tournament, player));`)
+	if err != nil {
+		return err
+	}
+	// databases created before players could drop lack the dropped column
+	_, err = db.Exec(`ALTER TABLE participants ADD COLUMN dropped INTEGER NOT NULL DEFAULT 0;`)
+	if err != nil && !strings.Contains(err.Error(), "duplicate column") {
+		return err
+	}
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS teams (id TEXT PRIMARY KEY, name TEXT);`)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS team_members (team TEXT, player TEXT, PRIMARY KEY (team, player));`)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS tournament_teams (tournament TEXT, team TEXT, dropped INTEGER NOT NULL DEFAULT 0, PRIMARY KEY (tournament, team));`)
 	if err != nil {
 		return err
 	}
@@ -349,9 +367,25 @@ func (s *Store) On(rec event.Record) {
 			log.Println("Projection: TournamentPlayerRegistered")
 			return nil
 		})
+	case TournamentWaitlistPromoted:
+		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
+			query := "INSERT INTO participants (tournament, player) VALUES (?, ?);"
+			_, err = t.Exec(query, e.Tournament, e.Player)
+			if err != nil {
+				return err
+			}
+			log.Println("Projection: TournamentWaitlistPromoted")
+			return nil
+		})
 	case TournamentPlayerDropped:
 		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
-			query := "DELETE FROM participants WHERE tournament = ? AND player = ?;"
+			// players dropping before the tournament began leave it, later ones stay in the standings
+			query := "DELETE FROM participants WHERE tournament = ? AND player = ? AND (SELECT phase FROM tournaments WHERE id = ?) IN (?, ?);"
+			_, err = t.Exec(query, e.Tournament, e.Player, e.Tournament, PhaseRegistration, PhaseCheckIn)
+			if err != nil {
+				return err
+			}
+			query = "UPDATE participants SET dropped = 1 WHERE tournament = ? AND player = ?;"
 			_, err = t.Exec(query, e.Tournament, e.Player)
 			if err != nil {
 				return err
@@ -359,6 +393,64 @@ func (s *Store) On(rec event.Record) {
 			log.Println("Projection: TournamentPlayerDropped")
 			return nil
 		})
+	case TournamentTeamRegistered:
+		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
+			query := "INSERT INTO tournament_teams (tournament, team) VALUES (?, ?);"
+			_, err = t.Exec(query, e.Tournament, e.Team)
+			if err != nil {
+				return err
+			}
+			query = "INSERT INTO participants (tournament, player) VALUES (?, ?);"
+			for _, pID := range e.Members {
+				_, err = t.Exec(query, e.Tournament, pID)
+				if err != nil {
+					return err
+				}
+			}
+			log.Println("Projection: TournamentTeamRegistered")
+			return nil
+		})
+	case TournamentTeamWaitlistPromoted:
+		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
+			query := "INSERT INTO tournament_teams (tournament, team) VALUES (?, ?);"
+			_, err = t.Exec(query, e.Tournament, e.Team)
+			if err != nil {
+				return err
+			}
+			query = "INSERT INTO participants (tournament, player) SELECT ?, player FROM team_members WHERE team = ?;"
+			_, err = t.Exec(query, e.Tournament, e.Team)
+			if err != nil {
+				return err
+			}
+			log.Println("Projection: TournamentTeamWaitlistPromoted")
+			return nil
+		})
+	case TournamentTeamDropped:
+		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
+			// teams dropping before the tournament began leave it, later ones stay in the standings
+			query := "DELETE FROM participants WHERE tournament = ? AND player IN (SELECT player FROM team_members WHERE team = ?) AND (SELECT phase FROM tournaments WHERE id = ?) IN (?, ?);"
+			_, err = t.Exec(query, e.Tournament, e.Team, e.Tournament, PhaseRegistration, PhaseCheckIn)
+			if err != nil {
+				return err
+			}
+			query = "DELETE FROM tournament_teams WHERE tournament = ? AND team = ? AND (SELECT phase FROM tournaments WHERE id = ?) IN (?, ?);"
+			_, err = t.Exec(query, e.Tournament, e.Team, e.Tournament, PhaseRegistration, PhaseCheckIn)
+			if err != nil {
+				return err
+			}
+			query = "UPDATE participants SET dropped = 1 WHERE tournament = ? AND player IN (SELECT player FROM team_members WHERE team = ?);"
+			_, err = t.Exec(query, e.Tournament, e.Team)
+			if err != nil {
+				return err
+			}
+			query = "UPDATE tournament_teams SET dropped = 1 WHERE tournament = ? AND team = ?;"
+			_, err = t.Exec(query, e.Tournament, e.Team)
+			if err != nil {
+				return err
+			}
+			log.Println("Projection: TournamentTeamDropped")
+			return nil
+		})
 	case TournamentFormatChanged:
 		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
 			query := "UPDATE tournaments SET format = ? WHERE id = ?;"
@@ -449,6 +541,33 @@ func (s *Store) On(rec event.Record) {
 			log.Println("Projection: PlayerRoleChanged")
 			return nil
 		})
+	case TeamCreated:
+		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
+			query := "INSERT INTO teams (id, name) VALUES (?, ?);"
+			_, err = t.Exec(query, e.Team, e.Name)
+			if err != nil {
+				return err
+			}
+			query = "INSERT INTO team_members (team, player) VALUES (?, ?);"
+			for _, pID := range e.Members {
+				_, err = t.Exec(query, e.Team, pID)
+				if err != nil {
+					return err
+				}
+			}
+			log.Println("Projection: TeamCreated")
+			return nil
+		})
+	case PlayerClaimed:
+		err = sqlutil.Transact(s.db, func(t *sql.Tx) error {
+			query := "UPDATE players SET mail = ?, pw = ?, role = ? WHERE id = ?;"
+			_, err = t.Exec(query, e.Mail, e.Password, e.Role, e.Player)
+			if err != nil {
+				return err
+			}
+			log.Println("Projection: PlayerClaimed")
+			return nil
+		})
 	}
 	if err != nil {
 		log.Println(err)
@@ -3,7 +3,9 @@ package tournaments
 import (
 	"fmt"
 	"log"
+	"math"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/cognicraft/hyper"
@@ -77,6 +79,10 @@ func (s *Server) handleGETStanding(w http.ResponseWriter, r *http.Request) {
 	}
 	// res.AddItem(trnsItem)
 	res.AddItem(parts)
+	res.AddItem(trn.MakeStandingsHyperItem(resolve))
+	if trn.TeamSize > 0 {
+		res.AddItem(trn.MakeTeamsHyperItem(resolve))
+	}
 	res.AddLink(selfLink)
 	if isHtmlReq {
 		err = templ.ExecuteTemplate(w, "standing.html", res)
@@ -88,3 +94,246 @@ func (s *Server) handleGETStanding(w http.ResponseWriter, r *http.Request) {
 		hyper.Write(w, http.StatusOK, res)
 	}
 }
+
+// minPercentage is the lower bound of every win percentage used as a tiebreaker.
+const minPercentage = 1.0 / 3
+
+// Standing is the position of a participant in the standings together with its tiebreakers.
+type Standing struct {
+	Rank                        int      `json:"rank"`
+	Player                      PlayerID `json:"player"`
+	Points                      int      `json:"points"`
+	Matches                     int      `json:"matches"`
+	MatchWins                   int      `json:"matchWins"`
+	Games                       int      `json:"games"`
+	GameWins                    int      `json:"gameWins"`
+	MatchWinPercentage          float64  `json:"matchWinPercentage"`
+	OpponentsMatchWinPercentage float64  `json:"opponentsMatchWinPercentage"`
+	GameWinPercentage           float64  `json:"gameWinPercentage"`
+	OpponentsGameWinPercentage  float64  `json:"opponentsGameWinPercentage"`
+	Dropped                     bool     `json:"dropped,omitempty"`
+}
+
+// rankParticipants orders all participants by their standings.
+func (trn *Tournament) rankParticipants() []PlayerID {
+	res := []PlayerID{}
+	for _, s := range trn.standings() {
+		res = append(res, s.Player)
+	}
+	return res
+}
+
+// standings ranks all participants by match points and then by the tiebreakers
+// of the tournament.
+func (trn *Tournament) standings() []Standing {
+	res := []Standing{}
+	mwp := map[PlayerID]float64{}
+	gwp := map[PlayerID]float64{}
+	for _, par := range trn.Participants {
+		mwp[par.Player] = trn.matchWinPercentage(par.Player)
+		gwp[par.Player] = trn.gameWinPercentage(par.Player)
+	}
+	for _, par := range trn.Participants {
+		s := Standing{
+			Player:             par.Player,
+			Points:             trn.matchPoints(par.Player),
+			Matches:            par.Matches,
+			MatchWins:          par.MatchWins,
+			Games:              par.Games,
+			GameWins:           par.GameWins,
+			MatchWinPercentage: mwp[par.Player],
+			GameWinPercentage:  gwp[par.Player],
+			Dropped:            par.Dropped,
+		}
+		opps := trn.opponents(par.Player)
+		for _, o := range opps {
+			s.OpponentsMatchWinPercentage += mwp[o]
+			s.OpponentsGameWinPercentage += gwp[o]
+		}
+		if len(opps) > 0 {
+			s.OpponentsMatchWinPercentage /= float64(len(opps))
+			s.OpponentsGameWinPercentage /= float64(len(opps))
+		}
+		res = append(res, s)
+	}
+	order := trn.tiebreakerOrder()
+	sort.SliceStable(res, func(i, j int) bool {
+		a, b := res[i], res[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		for _, tb := range order {
+			if va, vb := tiebreakerValue(a, tb), tiebreakerValue(b, tb); va != vb {
+				return va > vb
+			}
+		}
+		return false
+	})
+	for i := range res {
+		res[i].Rank = i + 1
+	}
+	return res
+}
+
+// standingMatches returns all ended matches of pID that count towards the standings.
+func (trn *Tournament) standingMatches(pID PlayerID) []Match {
+	res := []Match{}
+	for _, m := range trn.Matches {
+		if m.Ended && !m.Playoff && trn.sideOf(m, pID) != "" {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// opponents returns every opponent pID has played, once per match and each member of an
+// opposing Two-Headed Giant team. Byes do not count.
+func (trn *Tournament) opponents(pID PlayerID) []PlayerID {
+	res := []PlayerID{}
+	for _, m := range trn.standingMatches(pID) {
+		if m.Bye {
+			continue
+		}
+		if trn.sideOf(m, pID) == m.Player1 {
+			res = append(res, trn.sideMembers(m, m.Player2)...)
+		} else {
+			res = append(res, trn.sideMembers(m, m.Player1)...)
+		}
+	}
+	for _, pod := range trn.Pods {
+		if !pod.Ended || !containsPlayer(pod.Players, pID) {
+			continue
+		}
+		for _, p := range pod.Players {
+			if p != pID {
+				res = append(res, p)
+			}
+		}
+	}
+	return res
+}
+
+// matchWinPercentage returns the match points of pID divided by the points possible
+// in the rounds played, but at least minPercentage.
+func (trn *Tournament) matchWinPercentage(pID PlayerID) float64 {
+	rounds := len(trn.standingMatches(pID))
+	for _, pod := range trn.Pods {
+		if pod.Ended && containsPlayer(pod.Players, pID) {
+			rounds++
+		}
+	}
+	win := trn.pointsSystem().Win
+	if rounds == 0 || win <= 0 {
+		return minPercentage
+	}
+	return math.Max(float64(trn.matchPoints(pID))/float64(win*rounds), minPercentage)
+}
+
+// gameWinPercentage returns the game points of pID divided by the points possible
+// in the games played, but at least minPercentage. A bye counts as two won games.
+func (trn *Tournament) gameWinPercentage(pID PlayerID) float64 {
+	points, games := 0, 0
+	for _, m := range trn.standingMatches(pID) {
+		if m.Bye {
+			points += 3 * byeGameWins
+			games += byeGameWins
+			continue
+		}
+		for _, g := range m.Games {
+			if !g.Ended {
+				continue
+			}
+			games++
+			if g.Draw {
+				points++
+			} else if g.Winner == trn.sideOf(m, pID) {
+				points += 3
+			}
+		}
+	}
+	if games == 0 {
+		return minPercentage
+	}
+	return math.Max(float64(points)/float64(3*games), minPercentage)
+}
+
+func (trn *Tournament) MakeStandingsHyperItem(resolve hyper.ResolverFunc) hyper.Item {
+	res := hyper.Item{
+		Label: "Standings",
+		Type:  "standings",
+	}
+	for _, s := range trn.standings() {
+		item := hyper.Item{
+			Type: "standing",
+			ID:   string(s.Player),
+			Properties: []hyper.Property{
+				{
+					Label: "Rank",
+					Name:  "rank",
+					Value: s.Rank,
+				},
+				{
+					Label: "Player",
+					Name:  "player",
+					Value: s.Player,
+				},
+				{
+					Label: "Points",
+					Name:  "points",
+					Value: s.Points,
+				},
+				{
+					Label: "Matches",
+					Name:  "matches",
+					Value: s.Matches,
+				},
+				{
+					Label: "Match Wins",
+					Name:  "matchWins",
+					Value: s.MatchWins,
+				},
+				{
+					Label: "Games",
+					Name:  "games",
+					Value: s.Games,
+				},
+				{
+					Label: "Game Wins",
+					Name:  "gameWins",
+					Value: s.GameWins,
+				},
+				{
+					Label: "Match-Win %",
+					Name:  "matchWinPercentage",
+					Value: s.MatchWinPercentage,
+				},
+				{
+					Label: "Opponents' Match-Win %",
+					Name:  "opponentsMatchWinPercentage",
+					Value: s.OpponentsMatchWinPercentage,
+				},
+				{
+					Label: "Game-Win %",
+					Name:  "gameWinPercentage",
+					Value: s.GameWinPercentage,
+				},
+				{
+					Label: "Opponents' Game-Win %",
+					Name:  "opponentsGameWinPercentage",
+					Value: s.OpponentsGameWinPercentage,
+				},
+				{
+					Label: "Dropped",
+					Name:  "dropped",
+					Value: s.Dropped,
+				},
+			},
+		}
+		item.AddLink(hyper.Link{
+			Rel:  "details",
+			Href: resolve("../players/%s", s.Player).String(),
+		})
+		res.AddItem(item)
+	}
+	return res
+}
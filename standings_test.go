@@ -0,0 +1,94 @@
+package tournaments
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStandingsTiebreakers(t *testing.T) {
+	trn := Tournament{
+		Pairing:      PairingSwiss,
+		GamesToWin:   2,
+		Participants: []Participant{{Player: "a"}, {Player: "b"}, {Player: "c"}, {Player: "d"}},
+	}
+	// a beats b 2-0, c beats d 2-1, then a beats c 2-0 and d beats b 2-0
+	results := [][3]PlayerID{{"a", "b", ""}, {"c", "d", "d"}, {"a", "c", ""}, {"d", "b", ""}}
+	for i, r := range results {
+		trn.Matches = append(trn.Matches, Match{Player1: r[0], Player2: r[1], Round: i/2 + 1, Games: []Game{{}}})
+		games := []PlayerID{r[0], r[0]}
+		if r[2] != "" {
+			games = []PlayerID{r[0], r[2], r[0]}
+		}
+		for g, wnr := range games {
+			trn.Mutate(TournamentGameEnded{Match: i, Game: g, Winner: wnr})
+		}
+		trn.Mutate(TournamentMatchEnded{Match: i, Winner: r[0]})
+	}
+	st := trn.standings()
+	order := []PlayerID{}
+	for _, s := range st {
+		order = append(order, s.Player)
+	}
+	// c and d both have 3 points; c has the stronger opponents (a: 100%, d: 50%) vs d (c: 50%, b: 33%)
+	want := []PlayerID{"a", "c", "d", "b"}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("want: %v, got: %v", want, order)
+		}
+	}
+	b := st[3]
+	if b.MatchWinPercentage != minPercentage || b.GameWinPercentage != minPercentage {
+		t.Errorf("want: floored percentages for b, got: %v", b)
+	}
+	c := st[1]
+	if math.Abs(c.OpponentsMatchWinPercentage-0.75) > 1e-9 {
+		t.Errorf("want: OMW%% of %v for c, got: %v", 0.75, c.OpponentsMatchWinPercentage)
+	}
+}
+
+func TestPointsSystemAndTiebreakerOrder(t *testing.T) {
+	trn := Tournament{
+		Pairing:      PairingSwiss,
+		GamesToWin:   1,
+		Participants: []Participant{{Player: "a"}, {Player: "b"}, {Player: "c"}},
+	}
+	trn.Matches = []Match{
+		{Player1: "a", Player2: "b", Round: 1, Games: []Game{{Draw: true, Ended: true}}, Draw: true, Ended: true},
+		{Player1: "c", Winner: "c", Round: 1, Bye: true, Ended: true},
+	}
+	if trn.matchPoints("a") != 1 || trn.matchPoints("c") != 3 {
+		t.Errorf("default points: want: 1 and 3, got: %d and %d", trn.matchPoints("a"), trn.matchPoints("c"))
+	}
+	trn.Mutate(TournamentPointsSystemChanged{PointsSystem: PointsSystem{Win: 3, Draw: 2, Loss: 0, Bye: 1}})
+	if trn.matchPoints("a") != 2 || trn.matchPoints("c") != 1 {
+		t.Errorf("configured points: want: 2 and 1, got: %d and %d", trn.matchPoints("a"), trn.matchPoints("c"))
+	}
+	if err := validateTiebreakers([]string{TiebreakerGameWin, TiebreakerGameWin}); err == nil {
+		t.Errorf("want: error for duplicate tiebreakers")
+	}
+	trn.Mutate(TournamentTiebreakersChanged{Tiebreakers: []string{TiebreakerGameWin}})
+	if order := trn.tiebreakerOrder(); len(order) != 1 || order[0] != TiebreakerGameWin {
+		t.Errorf("want: configured tiebreaker order, got: %v", order)
+	}
+}
+
+func TestTwoHeadedGiantStandings(t *testing.T) {
+	trn := Tournament{Pairing: PairingSwiss, TeamSize: TeamSizeTwoHeadedGiant, GamesToWin: 1}
+	trn.Mutate(TournamentTeamRegistered{Team: "a", Name: "A", Members: []PlayerID{"1", "2"}})
+	trn.Mutate(TournamentTeamRegistered{Team: "b", Name: "B", Members: []PlayerID{"3", "4"}})
+	trn.Matches = []Match{{Player1: "1", Player2: "3", Team1: "a", Team2: "b", Round: 1, Games: []Game{{}}}}
+	trn.Mutate(TournamentGameEnded{Match: 0, Game: 0, Winner: "1"})
+	trn.Mutate(TournamentMatchEnded{Match: 0, Winner: "1"})
+	if opps := trn.opponents("2"); len(opps) != 2 || opps[0] != "3" || opps[1] != "4" {
+		t.Errorf("want: opponents 3 and 4 for the second member, got: %v", opps)
+	}
+	for _, s := range trn.standings() {
+		winner := s.Player == "1" || s.Player == "2"
+		if winner && (s.Points != 3 || s.GameWinPercentage != 1 || s.OpponentsMatchWinPercentage != minPercentage) {
+			t.Errorf("want: %v credited with the team's win, got: %+v", s.Player, s)
+		}
+		if !winner && s.OpponentsMatchWinPercentage != 1 {
+			t.Errorf("want: %v with 100%% OMW, got: %+v", s.Player, s)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package tournaments
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+const (
+	PairingRoundRobin        = "round-robin"
+	PairingSwiss             = "swiss"
+	PairingDoubleElimination = "double-elimination"
+)
+
+var pairings = []string{PairingRoundRobin, PairingSwiss, PairingDoubleElimination}
+
+//call on TournamentMatchesCreated
+// MakeSwissMatches creates the matches of a round from the pairs recorded with the event.
+// Rounds created before pairs were recorded are paired again as they were back then.
+func (trn *Tournament) MakeSwissMatches(round int, eventTime time.Time, seatPairing string, pairs [][2]PlayerID) {
+	if pairs == nil {
+		pairs = trn.swissPairs(round, eventTime, seatPairing)
+	}
+	for _, p := range pairs {
+		trn.Matches = append(trn.Matches, Match{Player1: p[0], Player2: p[1], Round: round, Games: []Game{{}}})
+	}
+}
+
+// swissPairs pairs all players that have not dropped for the given round. A player left
+// without an opponent is not part of any pair and receives a bye.
+func (trn *Tournament) swissPairs(round int, eventTime time.Time, seatPairing string) [][2]PlayerID {
+	res := [][2]PlayerID{}
+	if seats := trn.seatPairs(seatPairing); round == 1 && seats != nil {
+		for _, p := range seats {
+			if p[1] != "" {
+				res = append(res, p)
+			}
+		}
+		return res
+	}
+	plrs := trn.swissOrder(eventTime)
+	if len(plrs)%2 != 0 {
+		// the lowest ranked player who has not had a bye yet is left unpaired
+		idx := len(plrs) - 1
+		for i := len(plrs) - 1; i >= 0; i-- {
+			if !trn.hadBye(plrs[i]) {
+				idx = i
+				break
+			}
+		}
+		plrs = append(plrs[:idx], plrs[idx+1:]...)
+	}
+	groups := [][]PlayerID{plrs}
+	if trn.pairsInDraftPods(round) {
+		// the lowest ranked player of every odd pod is paired across pods
+		groups = trn.draftPodGroups(plrs)
+		leftovers := []PlayerID{}
+		for i, g := range groups {
+			if len(g)%2 != 0 {
+				leftovers = append(leftovers, g[len(g)-1])
+				groups[i] = g[:len(g)-1]
+			}
+		}
+		groups = append(groups, leftovers)
+	}
+	for _, g := range groups {
+		res = append(res, trn.pairSwissGroup(g)...)
+	}
+	return res
+}
+
+func (trn *Tournament) pairSwissGroup(plrs []PlayerID) [][2]PlayerID {
+	res := [][2]PlayerID{}
+	pairs := pairSwissOrTopDown(len(plrs), func(i, j int) bool {
+		return trn.havePlayed(plrs[i], plrs[j])
+	})
+	for _, p := range pairs {
+		res = append(res, [2]PlayerID{plrs[p[0]], plrs[p[1]]})
+	}
+	return res
+}
+
+// swissOrder returns all participants that have not dropped ordered by match points.
+// Players with equal points are shuffled with a seed derived from eventTime.
+func (trn *Tournament) swissOrder(eventTime time.Time) []PlayerID {
+	plrs := trn.activePlayers()
+	r := rand.New(rand.NewSource(eventTime.Unix()))
+	r.Shuffle(len(plrs), func(i, j int) {
+		plrs[i], plrs[j] = plrs[j], plrs[i]
+	})
+	points := map[PlayerID]int{}
+	for _, p := range plrs {
+		points[p] = trn.matchPoints(p)
+	}
+	sort.SliceStable(plrs, func(i, j int) bool {
+		return points[plrs[i]] > points[plrs[j]]
+	})
+	return plrs
+}
+
+// pairSwissOrTopDown pairs n ranked entrants by their index, avoiding rematches if possible.
+// If no pairing without rematches exists, entrants are paired top down.
+func pairSwissOrTopDown(n int, played func(i, j int) bool) [][2]int {
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	pairs, ok := pairSwiss(idx, played)
+	if ok {
+		return pairs
+	}
+	pairs = nil
+	for i := 0; i+1 < n; i += 2 {
+		pairs = append(pairs, [2]int{i, i + 1})
+	}
+	return pairs
+}
+
+// swissAttempts limits the pairings pairSwiss tries before giving up, since the
+// backtracking is exponential when few pairings without rematches are left.
+const swissAttempts = 10000
+
+// pairSwiss pairs the entrants in idx top down, backtracking whenever a pairing would
+// result in a rematch. ok is false if no such pairing exists or none was found
+// within swissAttempts tries.
+func pairSwiss(idx []int, played func(i, j int) bool) (pairs [][2]int, ok bool) {
+	attempts := swissAttempts
+	return pairSwissBounded(idx, played, &attempts)
+}
+
+func pairSwissBounded(idx []int, played func(i, j int) bool, attempts *int) (pairs [][2]int, ok bool) {
+	if len(idx) == 0 {
+		return nil, true
+	}
+	for i := 1; i < len(idx); i++ {
+		if played(idx[0], idx[i]) {
+			continue
+		}
+		if *attempts <= 0 {
+			return nil, false
+		}
+		*attempts--
+		rest := make([]int, 0, len(idx)-2)
+		rest = append(rest, idx[1:i]...)
+		rest = append(rest, idx[i+1:]...)
+		pairs, ok = pairSwissBounded(rest, played, attempts)
+		if ok {
+			return append([][2]int{{idx[0], idx[i]}}, pairs...), true
+		}
+	}
+	return nil, false
+}
+
+func (trn *Tournament) matchPoints(pID PlayerID) int {
+	points := 0
+	for _, m := range trn.Matches {
+		side := trn.sideOf(m, pID)
+		if !m.Ended || m.Playoff || side == "" {
+			continue
+		}
+		points += trn.resultPoints(m, side)
+	}
+	return points + trn.podPoints(pID)
+}
+
+func (trn *Tournament) havePlayed(a, b PlayerID) bool {
+	for _, m := range trn.Matches {
+		if (m.Player1 == a && m.Player2 == b) || (m.Player1 == b && m.Player2 == a) {
+			return true
+		}
+	}
+	return false
+}
+
+func (trn *Tournament) hadBye(pID PlayerID) bool {
+	for _, m := range trn.Matches {
+		if m.Bye && m.Player1 == pID {
+			return true
+		}
+	}
+	return false
+}
+
+// totalRounds returns the number of rounds set by the organizer.
+// If not set, swiss and pods play enough rounds to determine a single undefeated player.
+func (trn *Tournament) totalRounds() int {
+	n := len(trn.Participants)
+	if trn.TeamSize > 0 {
+		n = len(trn.Teams)
+	}
+	if trn.Pairing != PairingSwiss && trn.PodSize == 0 {
+		if n%2 != 0 {
+			return n
+		}
+		return n - 1
+	}
+	if trn.NumberOfRounds > 0 {
+		return trn.NumberOfRounds
+	}
+	if n <= 2 {
+		return 1
+	}
+	return int(math.Ceil(math.Log2(float64(n))))
+}
+
+func isPairingValid(p string) bool {
+	for _, v := range pairings {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package tournaments
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPairSwissAvoidsRematches(t *testing.T) {
+	played := func(a, b int) bool {
+		return (a == 0 && b == 1) || (a == 1 && b == 0)
+	}
+	pairs, ok := pairSwiss([]int{0, 1, 2, 3}, played)
+	if !ok {
+		t.Fatalf("no pairing found")
+	}
+	want := [][2]int{{0, 2}, {1, 3}}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Errorf("want: %v, got: %v", want, pairs)
+	}
+}
+
+func TestPairSwissGivesUp(t *testing.T) {
+	// two odd groups that have played across: no pairing without rematches exists
+	played := func(a, b int) bool {
+		return (a < 21) != (b < 21)
+	}
+	done := make(chan [][2]int)
+	go func() {
+		done <- pairSwissOrTopDown(40, played)
+	}()
+	select {
+	case pairs := <-done:
+		if len(pairs) != 20 || pairs[0] != [2]int{0, 1} {
+			t.Errorf("want: top down pairing, got: %v", pairs)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("pairing did not finish")
+	}
+}
+
+func TestMakeSwissMatches(t *testing.T) {
+	trn := Tournament{
+		Pairing:      PairingSwiss,
+		GamesToWin:   1,
+		Participants: []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}, {Player: "5"}},
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for round := 1; round <= 3; round++ {
+		trn.Mutate(TournamentMatchesCreated{Round: round, OccurredOn: start.Add(time.Duration(round) * time.Hour)})
+		for _, pID := range trn.unpairedPlayers(round) {
+			trn.Mutate(TournamentByeAwarded{Round: round, Player: pID})
+		}
+		for i, m := range trn.Matches {
+			if m.Ended {
+				continue
+			}
+			trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: m.Player1})
+			trn.Mutate(TournamentMatchEnded{Match: i, Winner: m.Player1})
+		}
+	}
+	byes := map[PlayerID]int{}
+	for i, a := range trn.Matches {
+		if a.Bye {
+			byes[a.Player1]++
+			continue
+		}
+		for _, b := range trn.Matches[i+1:] {
+			if !b.Bye && (a.Player1 == b.Player1 && a.Player2 == b.Player2 || a.Player1 == b.Player2 && a.Player2 == b.Player1) {
+				t.Errorf("rematch: %v", a)
+			}
+		}
+	}
+	for p, n := range byes {
+		if n > 1 {
+			t.Errorf("player %s received %d byes", p, n)
+		}
+	}
+	if len(byes) != 3 {
+		t.Errorf("want: %d byes, got %d", 3, len(byes))
+	}
+
+	replay := Tournament{
+		Pairing:      PairingSwiss,
+		GamesToWin:   1,
+		Participants: []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}, {Player: "5"}},
+	}
+	replay.Mutate(TournamentMatchesCreated{Round: 1, OccurredOn: start.Add(time.Hour)})
+	for i, m := range replay.Matches {
+		if m.Player1 != trn.Matches[i].Player1 || m.Player2 != trn.Matches[i].Player2 {
+			t.Errorf("pairings not reproducible: want %v, got %v", trn.Matches[i], m)
+		}
+	}
+}
+
+func TestSwissPairsRecorded(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Phase = PhaseRounds
+	trn.Pairing = PairingSwiss
+	trn.GamesToWin = 1
+	trn.Participants = []Participant{{Player: "1"}, {Player: "2"}, {Player: "3"}, {Player: "4"}, {Player: "5"}}
+	if err := trn.CreateMatches(); err != nil {
+		t.Fatal(err)
+	}
+	e, ok := trn.Changes()[0].(TournamentMatchesCreated)
+	if !ok || len(e.Pairs) != 2 {
+		t.Fatalf("want: two pairs recorded with the matches, got: %v", trn.Changes()[0])
+	}
+	for i, p := range e.Pairs {
+		if m := trn.Matches[i]; m.Player1 != p[0] || m.Player2 != p[1] {
+			t.Errorf("want: match %d from pair %v, got: %v", i, p, m)
+		}
+	}
+	// replay applies the recorded pairs, even a rematch the algorithm would avoid
+	replay := Tournament{Pairing: PairingSwiss, Participants: trn.Participants}
+	replay.Mutate(TournamentMatchesCreated{Round: 1, Pairs: [][2]PlayerID{{"1", "2"}, {"3", "4"}}})
+	replay.Mutate(TournamentMatchesCreated{Round: 2, Pairs: [][2]PlayerID{{"1", "2"}}})
+	if m := replay.Matches[2]; m.Round != 2 || m.Player1 != "1" || m.Player2 != "2" {
+		t.Errorf("want: recorded pair replayed, got: %v", m)
+	}
+}
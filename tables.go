@@ -0,0 +1,51 @@
+package tournaments
+
+// tableTaken reports whether table is used by another unended match of the same round.
+// Bracket matches, which have no round, share their tables among each other.
+func (trn *Tournament) tableTaken(match int, table int) bool {
+	for i, m := range trn.Matches {
+		if i != match && !m.Ended && m.Round == trn.Matches[match].Round && m.Table == table {
+			return true
+		}
+	}
+	return false
+}
+
+// fixedTableOf returns the fixed table of the first player of m who has one, or 0.
+func (trn *Tournament) fixedTableOf(m Match) int {
+	for _, side := range []PlayerID{m.Player1, m.Player2} {
+		for _, pID := range trn.sideMembers(m, side) {
+			if par := trn.getParticipantByID(pID); par != nil && par.FixedTable > 0 {
+				return par.FixedTable
+			}
+		}
+	}
+	return 0
+}
+
+// assignTables numbers the tables of all unended matches without a table. Matches
+// of players with a fixed table get that table if it is free; all others get the lowest
+// free table in the order the matches were created, so top pairings play at top tables.
+func (trn *Tournament) assignTables() {
+	open := []int{}
+	for i, m := range trn.Matches {
+		if m.Table == 0 && !m.Ended && !m.Bye {
+			open = append(open, i)
+		}
+	}
+	rest := []int{}
+	for _, i := range open {
+		if table := trn.fixedTableOf(trn.Matches[i]); table > 0 && !trn.tableTaken(i, table) {
+			trn.Matches[i].Table = table
+		} else {
+			rest = append(rest, i)
+		}
+	}
+	for _, i := range rest {
+		table := 1
+		for trn.tableTaken(i, table) {
+			table++
+		}
+		trn.Matches[i].Table = table
+	}
+}
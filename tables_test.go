@@ -0,0 +1,52 @@
+package tournaments
+
+import "testing"
+
+func TestAssignTables(t *testing.T) {
+	trn := NewTournament(nil)
+	trn.ID = "t"
+	trn.Phase = PhaseRounds
+	trn.GamesToWin = 2
+	trn.Pairing = PairingRoundRobin
+	for _, p := range []PlayerID{"1", "2", "3", "4", "5"} {
+		trn.Participants = append(trn.Participants, Participant{Player: p})
+	}
+	if err := trn.AssignFixedTable("5", 7); err != nil {
+		t.Fatal(err)
+	}
+	if err := trn.AssignFixedTable("4", 7); err == nil {
+		t.Errorf("want: error for a table fixed for another player")
+	}
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	for r := 1; r <= trn.totalRounds(); r++ {
+		tables := map[int]bool{}
+		for _, m := range trn.Matches {
+			if m.Round != r {
+				continue
+			}
+			if m.Table == 0 || tables[m.Table] {
+				t.Errorf("want: distinct tables in round %d, got: %v", r, trn.Matches)
+			}
+			tables[m.Table] = true
+			if (m.Player1 == "5" || m.Player2 == "5") && m.Table != 7 {
+				t.Errorf("want: fixed table 7 for 5, got: %v", m)
+			}
+		}
+		if len(tables) != 2 {
+			t.Errorf("want: 2 tables in round %d, got: %v", r, tables)
+		}
+	}
+	other := 0
+	for trn.Matches[other].Round != 1 || trn.Matches[other].Table == 7 {
+		other++
+	}
+	if err := trn.AssignTable(other, 7); err == nil {
+		t.Errorf("want: error for a table in use")
+	}
+	if err := trn.AssignTable(other, 12); err != nil {
+		t.Fatal(err)
+	}
+	if trn.Matches[other].Table != 12 {
+		t.Errorf("want: table 12, got: %v", trn.Matches[other])
+	}
+}
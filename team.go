@@ -0,0 +1,509 @@
+package tournaments
+
+import (
+	"math/rand"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cognicraft/event"
+	"github.com/cognicraft/hyper"
+)
+
+const (
+	TeamSizeTwoHeadedGiant = 2
+	TeamSizeTrios          = 3
+)
+
+type TeamID string
+
+type Team struct {
+	ID          TeamID         `json:"id"`
+	Version     uint64         `json:"version"`
+	Name        string         `json:"name"`
+	Members     []PlayerID     `json:"members"`
+	Tournaments []TournamentID `json:"tournaments"`
+	*event.ChangeRecorder
+}
+
+// TeamEntry is a Team as registered for a tournament.
+type TeamEntry struct {
+	ID      TeamID     `json:"id"`
+	Name    string     `json:"name"`
+	Members []PlayerID `json:"members"`
+	Dropped bool       `json:"dropped,omitempty"`
+}
+
+// TeamMatch is the result of two teams playing each other in a round,
+// derived from the individual matches of their members.
+type TeamMatch struct {
+	Round  int    `json:"round"`
+	Team1  TeamID `json:"team1"`
+	Team2  TeamID `json:"team2"`
+	Wins1  int    `json:"wins1"`
+	Wins2  int    `json:"wins2"`
+	Winner TeamID `json:"winner"`
+	Bye    bool   `json:"bye"`
+	Draw   bool   `json:"draw"`
+	Ended  bool   `json:"ended"`
+}
+
+func isTeamSizeValid(n int) bool {
+	return n == 0 || n == TeamSizeTwoHeadedGiant || n == TeamSizeTrios
+}
+
+func containsPlayer(list []PlayerID, pID PlayerID) bool {
+	for _, v := range list {
+		if v == pID {
+			return true
+		}
+	}
+	return false
+}
+
+func (trn *Tournament) getTeamByID(ID TeamID) *TeamEntry {
+	for i := 0; i < len(trn.Teams); i++ {
+		if trn.Teams[i].ID == ID {
+			return &trn.Teams[i]
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) teamOf(pID PlayerID) *TeamEntry {
+	for i := range trn.Teams {
+		for _, m := range trn.Teams[i].Members {
+			if m == pID {
+				return &trn.Teams[i]
+			}
+		}
+	}
+	return nil
+}
+
+// pairsPerRound reports whether matches are created at the start of every round
+// instead of all at once.
+func (trn *Tournament) pairsPerRound() bool {
+	return trn.Pairing == PairingSwiss || trn.TeamSize > 0 || trn.PodSize > 0
+}
+
+// seatsPerTeam returns the number of individual matches a team match consists of.
+// In Two-Headed Giant the whole team plays a single match together.
+func (trn *Tournament) seatsPerTeam() int {
+	if trn.TeamSize == TeamSizeTwoHeadedGiant {
+		return 1
+	}
+	return trn.TeamSize
+}
+
+// sideMembers returns every player on the side of pID in m. That is the whole team
+// if teammates play together, or just pID otherwise.
+func (trn *Tournament) sideMembers(m Match, pID PlayerID) []PlayerID {
+	if trn.TeamSize != TeamSizeTwoHeadedGiant || m.Team1 == "" {
+		return []PlayerID{pID}
+	}
+	tID := m.Team1
+	if pID == m.Player2 {
+		tID = m.Team2
+	}
+	team := trn.getTeamByID(tID)
+	if team == nil {
+		return []PlayerID{pID}
+	}
+	return team.Members
+}
+
+// matchPlayers returns all players taking part in the given match.
+func (trn *Tournament) matchPlayers(match int) []PlayerID {
+	m := trn.Matches[match]
+	res := trn.sideMembers(m, m.Player1)
+	if m.Player2 != "" {
+		res = append(append([]PlayerID{}, res...), trn.sideMembers(m, m.Player2)...)
+	}
+	return res
+}
+
+//call on TournamentMatchesCreated
+// MakeTeamMatches creates the team matches of a round. Swiss rounds use the pairs recorded
+// with the event, or are paired again if created before pairs were recorded.
+func (trn *Tournament) MakeTeamMatches(round int, eventTime time.Time, pairs [][2]TeamID) {
+	if trn.Pairing == PairingSwiss {
+		if pairs == nil {
+			pairs = trn.swissTeamPairs(eventTime)
+		}
+		for _, p := range pairs {
+			if p[1] == "" {
+				trn.makeTeamBye(round, trn.getTeamByID(p[0]))
+				continue
+			}
+			trn.makeTeamMatch(round, trn.getTeamByID(p[0]), trn.getTeamByID(p[1]))
+		}
+		return
+	}
+	rounds := roundRobinPairs(len(trn.Teams))
+	if round > len(rounds) {
+		return
+	}
+	forfeited := false
+	for _, p := range rounds[round-1] {
+		t1 := &trn.Teams[p[0]]
+		if p[1] < 0 {
+			if !t1.Dropped {
+				trn.makeTeamBye(round, t1)
+			}
+			continue
+		}
+		t2 := &trn.Teams[p[1]]
+		if t1.Dropped && t2.Dropped {
+			continue
+		}
+		first := len(trn.Matches)
+		trn.makeTeamMatch(round, t1, t2)
+		if t1.Dropped || t2.Dropped {
+			// the remaining team wins every seat against a dropped one
+			for i := first; i < len(trn.Matches); i++ {
+				trn.forfeitMatch(i)
+			}
+			forfeited = true
+		}
+	}
+	if forfeited {
+		trn.recountParticipants()
+	}
+}
+
+// swissTeamPairs pairs all teams that have not dropped. A team paired with "" receives a bye.
+func (trn *Tournament) swissTeamPairs(eventTime time.Time) [][2]TeamID {
+	res := [][2]TeamID{}
+	teams := []*TeamEntry{}
+	for i := range trn.Teams {
+		if !trn.Teams[i].Dropped {
+			teams = append(teams, &trn.Teams[i])
+		}
+	}
+	r := rand.New(rand.NewSource(eventTime.Unix()))
+	r.Shuffle(len(teams), func(i, j int) {
+		teams[i], teams[j] = teams[j], teams[i]
+	})
+	points := map[TeamID]int{}
+	for _, t := range teams {
+		points[t.ID] = trn.teamPoints(t.ID)
+	}
+	sort.SliceStable(teams, func(i, j int) bool {
+		return points[teams[i].ID] > points[teams[j].ID]
+	})
+	if len(teams)%2 != 0 {
+		// lowest ranked team that has not had a bye yet
+		idx := len(teams) - 1
+		for i := len(teams) - 1; i >= 0; i-- {
+			if !trn.teamHadBye(teams[i].ID) {
+				idx = i
+				break
+			}
+		}
+		res = append(res, [2]TeamID{teams[idx].ID, ""})
+		teams = append(teams[:idx], teams[idx+1:]...)
+	}
+	pairs := pairSwissOrTopDown(len(teams), func(i, j int) bool {
+		return trn.teamsHavePlayed(teams[i].ID, teams[j].ID)
+	})
+	for _, p := range pairs {
+		res = append(res, [2]TeamID{teams[p[0]].ID, teams[p[1]].ID})
+	}
+	return res
+}
+
+// makeTeamMatch pairs the members of both teams seat by seat.
+func (trn *Tournament) makeTeamMatch(round int, t1 *TeamEntry, t2 *TeamEntry) {
+	for s := 0; s < trn.seatsPerTeam(); s++ {
+		trn.Matches = append(trn.Matches, Match{
+			Player1: t1.Members[s],
+			Player2: t2.Members[s],
+			Team1:   t1.ID,
+			Team2:   t2.ID,
+			Round:   round,
+			Games:   []Game{{}},
+		})
+	}
+}
+
+func (trn *Tournament) makeTeamBye(round int, t *TeamEntry) {
+	for s := 0; s < trn.seatsPerTeam(); s++ {
+		trn.Matches = append(trn.Matches, Match{
+			Player1: t.Members[s],
+			Winner:  t.Members[s],
+			P1Count: byeGameWins,
+			Team1:   t.ID,
+			Round:   round,
+			Bye:     true,
+			Ended:   true,
+		})
+	}
+	for _, pID := range t.Members {
+		part := trn.getParticipantByID(pID)
+		part.Matches++
+		part.MatchWins++
+		part.Games += byeGameWins
+		part.GameWins += byeGameWins
+	}
+}
+
+// roundRobinPairs returns the pairings of n entrants for every round by their index,
+// using the circle method. An entrant paired with -1 has a bye.
+func roundRobinPairs(n int) [][][2]int {
+	idx := []int{}
+	for i := 0; i < n; i++ {
+		idx = append(idx, i)
+	}
+	if n%2 != 0 {
+		idx = append(idx, -1)
+	}
+	res := [][][2]int{}
+	for r := 0; r+1 < len(idx); r++ {
+		pairs := [][2]int{}
+		for i := 0; i < len(idx)/2; i++ {
+			a, b := idx[i], idx[len(idx)-1-i]
+			if a < 0 {
+				a, b = b, a
+			}
+			pairs = append(pairs, [2]int{a, b})
+		}
+		res = append(res, pairs)
+		// keep the first entrant fixed and rotate everybody else
+		idx = append([]int{idx[0], idx[len(idx)-1]}, idx[1:len(idx)-1]...)
+	}
+	return res
+}
+
+// teamMatches groups the individual matches of all team matches.
+func (trn *Tournament) teamMatches() []TeamMatch {
+	res := []TeamMatch{}
+	index := map[TeamMatch]int{}
+	for _, m := range trn.Matches {
+		if m.Team1 == "" {
+			continue
+		}
+		key := TeamMatch{Round: m.Round, Team1: m.Team1, Team2: m.Team2}
+		i, ok := index[key]
+		if !ok {
+			i = len(res)
+			index[key] = i
+			res = append(res, TeamMatch{Round: m.Round, Team1: m.Team1, Team2: m.Team2, Bye: m.Bye, Ended: true})
+		}
+		tm := &res[i]
+		tm.Ended = tm.Ended && m.Ended
+		if m.Winner != "" && m.Winner == m.Player1 {
+			tm.Wins1++
+		} else if m.Winner != "" && m.Winner == m.Player2 {
+			tm.Wins2++
+		}
+	}
+	for i := range res {
+		tm := &res[i]
+		if !tm.Ended {
+			continue
+		}
+		if tm.Wins1 > tm.Wins2 {
+			tm.Winner = tm.Team1
+		} else if tm.Wins2 > tm.Wins1 {
+			tm.Winner = tm.Team2
+		} else {
+			tm.Draw = true
+		}
+	}
+	return res
+}
+
+func (trn *Tournament) teamPoints(tID TeamID) int {
+	points := 0
+	for _, tm := range trn.teamMatches() {
+		if !tm.Ended || (tm.Team1 != tID && tm.Team2 != tID) {
+			continue
+		}
+		ps := trn.pointsSystem()
+		switch {
+		case tm.Bye:
+			points += ps.Bye
+		case tm.Draw:
+			points += ps.Draw
+		case tm.Winner == tID:
+			points += ps.Win
+		default:
+			points += ps.Loss
+		}
+	}
+	return points
+}
+
+func (trn *Tournament) teamsHavePlayed(a, b TeamID) bool {
+	for _, m := range trn.Matches {
+		if (m.Team1 == a && m.Team2 == b) || (m.Team1 == b && m.Team2 == a) {
+			return true
+		}
+	}
+	return false
+}
+
+func (trn *Tournament) teamHadBye(tID TeamID) bool {
+	for _, m := range trn.Matches {
+		if m.Bye && m.Team1 == tID {
+			return true
+		}
+	}
+	return false
+}
+
+// rankTeams orders all teams by match points and the match wins of their members.
+func (trn *Tournament) rankTeams() []TeamEntry {
+	teams := make([]TeamEntry, len(trn.Teams))
+	copy(teams, trn.Teams)
+	points := map[TeamID]int{}
+	wins := map[TeamID]int{}
+	for _, t := range teams {
+		points[t.ID] = trn.teamPoints(t.ID)
+		for _, pID := range t.Members {
+			if par := trn.getParticipantByID(pID); par != nil {
+				wins[t.ID] += par.MatchWins
+			}
+		}
+	}
+	sort.SliceStable(teams, func(i, j int) bool {
+		if points[teams[i].ID] != points[teams[j].ID] {
+			return points[teams[i].ID] > points[teams[j].ID]
+		}
+		return wins[teams[i].ID] > wins[teams[j].ID]
+	})
+	return teams
+}
+
+func (trn *Tournament) MakeTeamsHyperItem(resolve hyper.ResolverFunc) hyper.Item {
+	res := hyper.Item{
+		Label: "Teams",
+		Type:  "teams",
+	}
+	tms := trn.teamMatches()
+	for _, t := range trn.rankTeams() {
+		matches, wins, draws := 0, 0, 0
+		for _, tm := range tms {
+			if !tm.Ended || (tm.Team1 != t.ID && tm.Team2 != t.ID) {
+				continue
+			}
+			matches++
+			if tm.Draw {
+				draws++
+			} else if tm.Winner == t.ID {
+				wins++
+			}
+		}
+		res.AddItem(hyper.Item{
+			Label: t.Name,
+			Type:  "team",
+			ID:    string(t.ID),
+			Properties: []hyper.Property{
+				{
+					Label: "Name",
+					Name:  "name",
+					Value: t.Name,
+				},
+				{
+					Label: "Members",
+					Name:  "members",
+					Value: t.Members,
+				},
+				{
+					Label: "Matches",
+					Name:  "matches",
+					Value: matches,
+				},
+				{
+					Label: "Match Wins",
+					Name:  "matchWins",
+					Value: wins,
+				},
+				{
+					Label: "Draws",
+					Name:  "draws",
+					Value: draws,
+				},
+				{
+					Label: "Points",
+					Name:  "points",
+					Value: trn.teamPoints(t.ID),
+				},
+				{
+					Label: "Dropped",
+					Name:  "dropped",
+					Value: t.Dropped,
+				},
+			},
+			Links: []hyper.Link{
+				{
+					Rel:  "details",
+					Href: resolve("/api/teams/%s", t.ID).String(),
+				},
+			},
+		})
+	}
+	return res
+}
+
+func (s *Server) handleGETTeam(w http.ResponseWriter, r *http.Request) {
+	isHtmlReq := strings.Contains(r.Header.Get("Accept"), "text/html")
+	resolve := hyper.ExternalURLResolver(r)
+	tID := TeamID(r.Context().Value(":id").(string))
+
+	team, err := LoadTeam(s.es, tID)
+	if err != nil {
+		handleError(w, http.StatusNotFound, err, isHtmlReq)
+		return
+	}
+
+	res := team.MakeTeamHyperItem(resolve)
+
+	if isHtmlReq {
+		err = templ.ExecuteTemplate(w, "team.html", res)
+		if err != nil {
+			handleError(w, http.StatusInternalServerError, err, isHtmlReq)
+			return
+		}
+	} else {
+		hyper.Write(w, http.StatusOK, res)
+	}
+}
+
+func (team *Team) MakeTeamHyperItem(resolve hyper.ResolverFunc) hyper.Item {
+	res := hyper.Item{
+		Label: team.Name,
+		Type:  "team",
+		ID:    string(team.ID),
+		Properties: hyper.Properties{
+			{
+				Label: "Name",
+				Name:  "name",
+				Value: team.Name,
+			},
+			{
+				Label: "Members",
+				Name:  "members",
+				Value: team.Members,
+			},
+			{
+				Label: "Tournaments",
+				Name:  "tournaments",
+				Value: team.Tournaments,
+			},
+		},
+	}
+	if len(team.Tournaments) > 0 {
+		res.AddLink(hyper.Link{
+			Rel:  "details",
+			Href: resolve("/api/tournaments/%s", team.Tournaments[len(team.Tournaments)-1]).String(),
+		})
+	}
+	res.AddLink(hyper.Link{
+		Rel:  hyper.RelSelf,
+		Href: resolve("./%s", team.ID).String(),
+	})
+	return res
+}
@@ -0,0 +1,131 @@
+package tournaments
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/cognicraft/event"
+	"github.com/cognicraft/uuid"
+)
+
+type TeamCreated struct {
+	ID         string     `json:"id"`
+	OccurredOn time.Time  `json:"occurred-on"`
+	Team       TeamID     `json:"team"`
+	Name       string     `json:"name"`
+	Members    []PlayerID `json:"members"`
+}
+
+type TeamTournamentRegistered struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Team       TeamID       `json:"team"`
+	Tournament TournamentID `json:"tournament"`
+}
+
+func NewTeam() *Team {
+	return &Team{
+		ChangeRecorder: event.NewChangeRecorder(),
+	}
+}
+
+func (team *Team) Create(id TeamID, name string, members []PlayerID) error {
+	if team.ID != "" {
+		return fmt.Errorf("Team already exists")
+	}
+	if id == "" {
+		return fmt.Errorf("A Team's ID may not be empty")
+	}
+	if name == "" {
+		return fmt.Errorf("Team Name not specified")
+	}
+	if len(members) == 0 {
+		return fmt.Errorf("A Team needs Members")
+	}
+	team.Apply(TeamCreated{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Team:       id,
+		Name:       name,
+		Members:    members,
+	})
+	log.Printf("Event: Team %v: Created\n", team.ID)
+	return nil
+}
+
+func (team *Team) RegisterTournament(tID TournamentID) error {
+	if team.ID == "" {
+		return fmt.Errorf("Team does not exist")
+	}
+	if tID == "" {
+		return fmt.Errorf("No Tournament specified")
+	}
+	team.Apply(TeamTournamentRegistered{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Team:       team.ID,
+		Tournament: tID,
+	})
+	log.Printf("Event: Team %s: Tournament %s registered", team.ID, tID)
+	return nil
+}
+
+func (team *Team) Mutate(e event.Event) {
+	team.Version++
+	switch e := e.(type) {
+	case TeamCreated:
+		team.ID = e.Team
+		team.Name = e.Name
+		team.Members = e.Members
+	case TeamTournamentRegistered:
+		team.Tournaments = append(team.Tournaments, e.Tournament)
+	}
+}
+
+func (team *Team) Apply(e event.Event) {
+	team.Record(e)
+	team.Mutate(e)
+}
+
+func (team *Team) Save(es *event.Store, metadata interface{}) error {
+	if len(team.Changes()) == 0 {
+		return nil
+	}
+	streamID := string(team.ID)
+	exp := team.Version - uint64(len(team.Changes()))
+	codec, err := Codec()
+	if err != nil {
+		return err
+	}
+	recs, err := codec.EncodeAll(team.Changes(), event.WithMetadata(metadata))
+	if err != nil {
+		return err
+	}
+	err = es.Append(streamID, exp, recs)
+	if err != nil {
+		return err
+	}
+	team.ClearChanges()
+	return nil
+}
+
+func LoadTeam(es *event.Store, tID TeamID) (*Team, error) {
+	codec, err := Codec()
+	if err != nil {
+		return nil, err
+	}
+	team := NewTeam()
+	streamID := string(tID)
+	for rec := range es.Load(streamID) {
+		e, err := codec.Decode(rec)
+		if err != nil {
+			return nil, err
+		}
+		team.Mutate(e)
+	}
+	if team.ID == "" {
+		return nil, fmt.Errorf("Team not found")
+	}
+	return team, nil
+}
@@ -0,0 +1,127 @@
+package tournaments
+
+import "testing"
+
+func TestRoundRobinPairs(t *testing.T) {
+	for _, n := range []int{2, 3, 4, 5, 6} {
+		met := map[[2]int]int{}
+		for _, pairs := range roundRobinPairs(n) {
+			for _, p := range pairs {
+				if p[1] < 0 {
+					continue
+				}
+				if p[0] > p[1] {
+					p[0], p[1] = p[1], p[0]
+				}
+				met[p]++
+			}
+		}
+		if len(met) != n*(n-1)/2 {
+			t.Errorf("%d entrants: want: %d pairings, got: %d", n, n*(n-1)/2, len(met))
+		}
+		for p, c := range met {
+			if c != 1 {
+				t.Errorf("%d entrants: %v met %d times", n, p, c)
+			}
+		}
+	}
+}
+
+func TestTriosTeamMatch(t *testing.T) {
+	trn := Tournament{Pairing: PairingRoundRobin, TeamSize: TeamSizeTrios, GamesToWin: 1}
+	trn.Mutate(TournamentTeamRegistered{Team: "a", Name: "A", Members: []PlayerID{"1", "2", "3"}})
+	trn.Mutate(TournamentTeamRegistered{Team: "b", Name: "B", Members: []PlayerID{"4", "5", "6"}})
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	if len(trn.Matches) != 3 {
+		t.Fatalf("want: %d seat matches, got: %d", 3, len(trn.Matches))
+	}
+	// team B wins two of three seats
+	for i, wnr := range []PlayerID{"1", "5", "6"} {
+		trn.Mutate(TournamentGameEnded{Match: i, Game: 0, Winner: wnr})
+		trn.Mutate(TournamentMatchEnded{Match: i, Winner: wnr})
+	}
+	tms := trn.teamMatches()
+	if len(tms) != 1 || tms[0].Winner != "b" {
+		t.Fatalf("want: team b to win, got: %v", tms)
+	}
+	if trn.teamPoints("b") != 3 || trn.teamPoints("a") != 0 {
+		t.Errorf("unexpected points: a %d, b %d", trn.teamPoints("a"), trn.teamPoints("b"))
+	}
+	if par := trn.getParticipantByID("1"); par.MatchWins != 1 || par.Matches != 1 {
+		t.Errorf("want: individual stats for player 1, got: %v", *par)
+	}
+}
+
+func TestDropTeamDuringRounds(t *testing.T) {
+	trn := Tournament{Pairing: PairingRoundRobin, TeamSize: TeamSizeTrios, GamesToWin: 1}
+	trn.Mutate(TournamentTeamRegistered{Team: "a", Name: "A", Members: []PlayerID{"1", "2", "3"}})
+	trn.Mutate(TournamentTeamRegistered{Team: "b", Name: "B", Members: []PlayerID{"4", "5", "6"}})
+	trn.Mutate(TournamentTeamRegistered{Team: "c", Name: "C", Members: []PlayerID{"7", "8", "9"}})
+	trn.Phase = PhaseRounds
+	// round 1: a has a bye, b plays c
+	trn.Mutate(TournamentMatchesCreated{Round: 1})
+	trn.Mutate(TournamentGameEnded{Match: 3, Game: 0, Winner: "7"})
+	trn.Mutate(TournamentTeamDropped{Team: "c"})
+	if team := trn.getTeamByID("c"); team == nil || !team.Dropped || !trn.isDropped("8") {
+		t.Fatalf("want: team c and its members kept as dropped, got: %v", trn.Teams)
+	}
+	for i := 3; i < 6; i++ {
+		if m := trn.Matches[i]; m.Bye || !m.Ended || m.Winner != m.Player1 || m.Team1 != "b" {
+			t.Errorf("want: seat match %d forfeited to team b, got: %v", i, m)
+		}
+	}
+	if len(trn.Matches[3].Games) != 1 || trn.getParticipantByID("7").GameWins != 1 {
+		t.Errorf("want: games played before the drop kept, got: %v", trn.Matches[3])
+	}
+	// round 2: a plays the dropped c, b has a bye
+	trn.Mutate(TournamentMatchesCreated{Round: 2})
+	for _, m := range trn.Matches[6:] {
+		if m.Team1 == "a" && m.Team2 == "c" && (m.Bye || !m.Ended || m.Winner != m.Player1) {
+			t.Errorf("want: match against dropped team forfeited, got: %v", m)
+		}
+	}
+	// round 3: the bye of the dropped team is skipped
+	n := len(trn.Matches)
+	trn.Mutate(TournamentMatchesCreated{Round: 3})
+	for _, m := range trn.Matches[n:] {
+		if m.Team1 == "c" {
+			t.Errorf("want: no bye for dropped team, got: %v", m)
+		}
+	}
+	if trn.teamPoints("a") != 6 || trn.teamPoints("c") != 0 {
+		t.Errorf("unexpected points: a %d, c %d", trn.teamPoints("a"), trn.teamPoints("c"))
+	}
+}
+
+func TestTeam(t *testing.T) {
+	team := NewTeam()
+	if err := team.RegisterTournament("t"); err == nil {
+		t.Errorf("want: error for a team that does not exist")
+	}
+	if err := team.Create("a", "A", []PlayerID{"1", "2"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := team.Create("a", "A", []PlayerID{"1", "2"}); err == nil {
+		t.Errorf("want: error for creating a team twice")
+	}
+	if err := team.RegisterTournament("t"); err != nil {
+		t.Fatal(err)
+	}
+	if team.ID != "a" || len(team.Members) != 2 || len(team.Tournaments) != 1 || len(team.Changes()) != 2 {
+		t.Errorf("unexpected team: %v", team)
+	}
+}
+
+func TestSwissTeamPairsRecorded(t *testing.T) {
+	trn := Tournament{Pairing: PairingSwiss, TeamSize: TeamSizeTwoHeadedGiant, GamesToWin: 1}
+	trn.Mutate(TournamentTeamRegistered{Team: "a", Name: "A", Members: []PlayerID{"1", "2"}})
+	trn.Mutate(TournamentTeamRegistered{Team: "b", Name: "B", Members: []PlayerID{"3", "4"}})
+	trn.Mutate(TournamentTeamRegistered{Team: "c", Name: "C", Members: []PlayerID{"5", "6"}})
+	trn.Mutate(TournamentMatchesCreated{Round: 1, TeamPairs: [][2]TeamID{{"c", "a"}, {"b", ""}}})
+	if len(trn.Matches) != 2 || trn.Matches[0].Team1 != "c" || trn.Matches[0].Team2 != "a" {
+		t.Fatalf("want: recorded team pair c VS a, got: %v", trn.Matches)
+	}
+	if !trn.teamHadBye("b") {
+		t.Errorf("want: recorded bye for team b")
+	}
+}
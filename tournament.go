@@ -14,31 +14,59 @@ import (
 )
 
 type Tournament struct {
-	ID           TournamentID  `json:"id"`
-	Version      uint64        `json:"version"`
-	Name         string        `json:"name"`
-	Phase        Phase         `json:"phase"`
-	Start        string        `json:"start,omitempty"`
-	End          string        `json:"end,omitempty"`
-	Format       string        `json:"format,omitempty"`
-	MaxPlayers   int           `json:"maxplayers,omitempty"`
-	Seats        []Seat        `json:"seats"`
-	Matches      []Match       `json:"matches"`
-	GamesToWin   int           `json:"gamesToWin"`
-	Participants []Participant `json:"players,omitempty"`
-	Deleted      bool          `json:"deleted"`
+	ID              TournamentID  `json:"id"`
+	Version         uint64        `json:"version"`
+	Name            string        `json:"name"`
+	Phase           Phase         `json:"phase"`
+	Start           string        `json:"start,omitempty"`
+	End             string        `json:"end,omitempty"`
+	Format          string        `json:"format,omitempty"`
+	Pairing         string        `json:"pairing,omitempty"`
+	SeatPairing     string        `json:"seatPairing,omitempty"`
+	PointsSystem    *PointsSystem `json:"pointsSystem,omitempty"`
+	Tiebreakers     []string      `json:"tiebreakers,omitempty"`
+	NumberOfRounds  int           `json:"numberOfRounds,omitempty"`
+	RoundTime       int           `json:"roundTime,omitempty"`
+	Schedule        Schedule      `json:"schedule"`
+	TopCut          int           `json:"topCut,omitempty"`
+	Seeds           []PlayerID    `json:"seeds,omitempty"`
+	Champion        PlayerID      `json:"champion,omitempty"`
+	DecklistsWaived bool          `json:"decklistsWaived,omitempty"`
+	CardList        []string      `json:"cardList,omitempty"`
+	PoolSize        int           `json:"poolSize,omitempty"`
+	TeamSize        int           `json:"teamSize,omitempty"`
+	Teams           []TeamEntry   `json:"teams,omitempty"`
+	PodSize         int           `json:"podSize,omitempty"`
+	PlacementPoints []int         `json:"placementPoints,omitempty"`
+	Pods            []Pod         `json:"pods,omitempty"`
+	MaxPlayers      int           `json:"maxplayers,omitempty"`
+	Seats           []Seat        `json:"seats"`
+	Rounds          []Round       `json:"rounds"`
+	Matches         []Match       `json:"matches"`
+	GamesToWin      int           `json:"gamesToWin"`
+	Participants    []Participant `json:"players,omitempty"`
+	Waitlist        []PlayerID    `json:"waitlist,omitempty"`
+	TeamWaitlist    []TeamEntry   `json:"teamWaitlist,omitempty"`
+	Infractions     []Infraction  `json:"infractions,omitempty"`
+	Deleted         bool          `json:"deleted"`
 	*event.ChangeRecorder
 	Server *Server
 }
 
 type Participant struct {
-	Player    PlayerID `json:"player"`
-	SeatIndex int      `json:"seatIndex"`
-	Deck      DeckID   `json:"deck"`
-	Matches   int      `json:"matches"`
-	Games     int      `json:"games"`
-	MatchWins int      `json:"matchWins"`
-	GameWins  int      `json:"gameWins"`
+	Player     PlayerID `json:"player"`
+	SeatIndex  int      `json:"seatIndex"`
+	DraftPod   int      `json:"draftPod"`
+	Deck       DeckID   `json:"deck"`
+	Pool       []string `json:"pool,omitempty"`
+	DeckCards  []string `json:"deckCards,omitempty"`
+	Matches    int      `json:"matches"`
+	Games      int      `json:"games"`
+	MatchWins  int      `json:"matchWins"`
+	GameWins   int      `json:"gameWins"`
+	Dropped    bool     `json:"dropped,omitempty"`
+	FixedTable int      `json:"fixedTable,omitempty"`
+	CheckedIn  bool     `json:"checkedIn,omitempty"`
 }
 
 type Seat struct {
@@ -49,15 +77,50 @@ type Seat struct {
 type TournamentID string
 
 const (
-	ActionDelete           = "delete"
-	ActionRegisterPlayer   = "register-player"
-	ActionDropPlayer       = "drop-player"
-	ActionCreate           = "create"
-	ActionChangeFormat     = "change-format"
-	ActionChangeMaxPlayers = "change-maxplayers"
-	ActionEndPhase         = "end-phase"
-	ActionEndGame          = "end-game"
-	ActionChangeGamesToWin = "change-gamestowin"
+	ActionDelete             = "delete"
+	ActionRegisterPlayer     = "register-player"
+	ActionDropPlayer         = "drop-player"
+	ActionRegisterGuest      = "register-guest"
+	ActionCreate             = "create"
+	ActionChangeFormat       = "change-format"
+	ActionChangeMaxPlayers   = "change-maxplayers"
+	ActionEndPhase           = "end-phase"
+	ActionEndGame            = "end-game"
+	ActionChangeGamesToWin   = "change-gamestowin"
+	ActionChangePairing      = "change-pairing"
+	ActionChangeSeatPairing  = "change-seatpairing"
+	ActionChangeRounds       = "change-rounds"
+	ActionStartRound         = "start-round"
+	ActionEndRound           = "end-round"
+	ActionChangeTopCut       = "change-topcut"
+	ActionChoosePlayDraw     = "choose-play-draw"
+	ActionSubmitDeck         = "submit-deck"
+	ActionWaiveDecklists     = "waive-decklists"
+	ActionChangeCardList     = "change-cardlist"
+	ActionChangePoolSize     = "change-poolsize"
+	ActionBuildDeck          = "build-deck"
+	ActionChangeTeamSize     = "change-teamsize"
+	ActionRegisterTeam       = "register-team"
+	ActionDropTeam           = "drop-team"
+	ActionChangePodSize      = "change-podsize"
+	ActionChangePoints       = "change-placementpoints"
+	ActionReportPod          = "report-pod"
+	ActionChangePointsSystem = "change-pointssystem"
+	ActionChangeTiebreakers  = "change-tiebreakers"
+	ActionConfirmResult      = "confirm-result"
+	ActionDisputeResult      = "dispute-result"
+	ActionCorrectGame        = "correct-game"
+	ActionCorrectMatch       = "correct-match"
+	ActionRecordInfraction   = "record-infraction"
+	ActionChangeRoundTime    = "change-roundtime"
+	ActionStartClock         = "start-clock"
+	ActionCallTime           = "call-time"
+	ActionConcludeMatch      = "conclude-match"
+	ActionIntentionalDraw    = "intentional-draw"
+	ActionAssignTable        = "assign-table"
+	ActionAssignFixedTable   = "assign-fixedtable"
+	ActionChangeSchedule     = "change-schedule"
+	ActionCheckIn            = "check-in"
 )
 
 const (
@@ -71,6 +134,32 @@ const (
 	ArgumentGame         = "game"
 	ArgumentGamesToWin   = "gamestowin"
 	ArgumentDraw         = "draw"
+	ArgumentPairing      = "pairing"
+	ArgumentSeatPairing  = "seatpairing"
+	ArgumentRounds       = "rounds"
+	ArgumentTopCut       = "topcut"
+	ArgumentPlay         = "play"
+	ArgumentDeck         = "deck"
+	ArgumentCards        = "cards"
+	ArgumentPoolSize     = "poolsize"
+	ArgumentTeamSize     = "teamsize"
+	ArgumentTeam         = "team"
+	ArgumentMembers      = "members"
+	ArgumentPodSize      = "podsize"
+	ArgumentPoints       = "points"
+	ArgumentPod          = "pod"
+	ArgumentPlacements   = "placements"
+	ArgumentWin          = "win"
+	ArgumentLoss         = "loss"
+	ArgumentBye          = "bye"
+	ArgumentTiebreakers  = "tiebreakers"
+	ArgumentPenalty      = "penalty"
+	ArgumentReason       = "reason"
+	ArgumentRoundTime    = "roundtime"
+	ArgumentTable        = "table"
+	ArgumentOpen         = "open"
+	ArgumentClose        = "close"
+	ArgumentStart        = "start"
 )
 
 func (s *Server) handleGETTournaments(w http.ResponseWriter, r *http.Request) {
@@ -122,8 +211,12 @@ func (s *Server) handleGETTournament(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	res.AddItem(plrs)
+	res.AddItem(trn.MakeStandingsHyperItem(resolve))
+	if trn.TeamSize > 0 {
+		res.AddItem(trn.MakeTeamsHyperItem(resolve))
+	}
 	if isHtmlReq {
-		err = switchPhase(trn.Phase, w, r, res)
+		err = switchPhase(trn, w, r, res)
 		if err != nil {
 			log.Println(err)
 		}
@@ -154,23 +247,45 @@ func (s *Server) handlePOSTTournament(w http.ResponseWriter, r *http.Request) {
 		handleError(w, http.StatusInternalServerError, err, isHtmlReq)
 		return
 	}
+	if !trn.allows(cmd.Action) {
+		handleError(w, http.StatusBadRequest, fmt.Errorf("Action not allowed in Format %s: %s", trn.Format, cmd.Action), isHtmlReq)
+		return
+	}
 	switch cmd.Action {
 	case ActionRegisterPlayer:
 		pID := PlayerID(cmd.Arguments.String(ArgumentPlayerID))
 		if pID == "" {
 			pID = accID
 		}
-		if accID != pID {
+		if accID != pID && !editable {
 			handleError(w, http.StatusForbidden, fmt.Errorf("You can only register yourself"), isHtmlReq)
 			return
 		}
 		err = trn.RegisterPlayer(pID)
+	case ActionRegisterGuest:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		// refuse before the guest is created, a guest that can not register would be left behind
+		err = trn.checkPlayerRegistration()
+		if err != nil {
+			handleError(w, http.StatusBadRequest, err, isHtmlReq)
+			return
+		}
+		var guest *Player
+		guest, err = s.CreateGuest(cmd.Arguments.String(ArgumentName))
+		if err != nil {
+			handleError(w, http.StatusBadRequest, err, isHtmlReq)
+			return
+		}
+		err = trn.RegisterPlayer(guest.ID)
 	case ActionDropPlayer:
 		pID := PlayerID(cmd.Arguments.String(ArgumentPlayerID))
 		if pID == "" {
 			pID = accID
 		}
-		if accID != pID {
+		if accID != pID && !editable {
 			handleError(w, http.StatusForbidden, fmt.Errorf("You can only drop yourself"), isHtmlReq)
 			return
 		}
@@ -202,6 +317,256 @@ func (s *Server) handlePOSTTournament(w http.ResponseWriter, r *http.Request) {
 		}
 		f := cmd.Arguments.String(ArgumentFormat)
 		err = trn.ChangeFormat(f)
+	case ActionChangePairing:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		p := cmd.Arguments.String(ArgumentPairing)
+		err = trn.ChangePairing(p)
+	case ActionChangeSeatPairing:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		p := cmd.Arguments.String(ArgumentSeatPairing)
+		err = trn.ChangeSeatPairing(p)
+	case ActionChangeRounds:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		n := cmd.Arguments.Int(ArgumentRounds)
+		err = trn.ChangeNumberOfRounds(n)
+	case ActionChangeRoundTime:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		minutes := cmd.Arguments.Int(ArgumentRoundTime)
+		err = trn.ChangeRoundTime(minutes)
+	case ActionChangeSchedule:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		var sched Schedule
+		sched, err = scheduleArgument(cmd.Arguments)
+		if err != nil {
+			handleError(w, http.StatusBadRequest, err, isHtmlReq)
+			return
+		}
+		err = trn.ChangeSchedule(sched)
+	case ActionChangeTopCut:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		n := cmd.Arguments.Int(ArgumentTopCut)
+		err = trn.ChangeTopCut(n)
+	case ActionChangePointsSystem:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.ChangePointsSystem(PointsSystem{
+			Win:  cmd.Arguments.Int(ArgumentWin),
+			Draw: cmd.Arguments.Int(ArgumentDraw),
+			Loss: cmd.Arguments.Int(ArgumentLoss),
+			Bye:  cmd.Arguments.Int(ArgumentBye),
+		})
+	case ActionChangeTiebreakers:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.ChangeTiebreakers(stringsArgument(cmd.Arguments, ArgumentTiebreakers))
+	case ActionChoosePlayDraw:
+		m := cmd.Arguments.Int(ArgumentMatch)
+		pID := PlayerID(cmd.Arguments.String(ArgumentPlayerID))
+		if pID == "" {
+			pID = accID
+		}
+		if accID != pID && !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("You can only choose for yourself"), isHtmlReq)
+			return
+		}
+		play := cmd.Arguments.Bool(ArgumentPlay)
+		err = trn.ChoosePlayDraw(m, pID, play)
+	case ActionStartRound:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.StartRound()
+	case ActionStartClock:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.StartClock()
+	case ActionCallTime:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.CallTime()
+	case ActionConcludeMatch:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Only Organizers can conclude Matches"), isHtmlReq)
+			return
+		}
+		m := cmd.Arguments.Int(ArgumentMatch)
+		draw := cmd.Arguments.Bool(ArgumentDraw)
+		err = trn.ConcludeMatch(m, draw)
+	case ActionAssignTable:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		m := cmd.Arguments.Int(ArgumentMatch)
+		table := cmd.Arguments.Int(ArgumentTable)
+		err = trn.AssignTable(m, table)
+	case ActionAssignFixedTable:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		pID := cmd.Arguments.String(ArgumentPlayerID)
+		table := cmd.Arguments.Int(ArgumentTable)
+		err = trn.AssignFixedTable(PlayerID(pID), table)
+	case ActionCheckIn:
+		pID := PlayerID(cmd.Arguments.String(ArgumentPlayerID))
+		if pID == "" {
+			pID = accID
+		}
+		if accID != pID && !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("You can only check in yourself"), isHtmlReq)
+			return
+		}
+		err = trn.CheckIn(pID)
+	case ActionIntentionalDraw:
+		m := cmd.Arguments.Int(ArgumentMatch)
+		if editable {
+			err = trn.RecordIntentionalDraw(m)
+		} else {
+			// both players have to agree on an intentional draw
+			err = trn.OfferIntentionalDraw(m, accID)
+		}
+	case ActionEndRound:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.EndRound()
+	case ActionSubmitDeck:
+		pID := PlayerID(cmd.Arguments.String(ArgumentPlayerID))
+		if pID == "" {
+			pID = accID
+		}
+		if accID != pID && !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("You can only submit your own Deck"), isHtmlReq)
+			return
+		}
+		var dck Deck
+		dck, err = s.p.FindDeckByID(DeckID(cmd.Arguments.String(ArgumentDeck)))
+		if err != nil {
+			handleError(w, http.StatusInternalServerError, err, isHtmlReq)
+			return
+		}
+		if dck.ID == "" {
+			handleError(w, http.StatusNotFound, fmt.Errorf("Deck not found"), isHtmlReq)
+			return
+		}
+		err = trn.SubmitDeck(pID, dck.ID)
+	case ActionBuildDeck:
+		pID := PlayerID(cmd.Arguments.String(ArgumentPlayerID))
+		if pID == "" {
+			pID = accID
+		}
+		if accID != pID && !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("You can only build your own Deck"), isHtmlReq)
+			return
+		}
+		err = trn.BuildDeck(pID, parseCardList(cmd.Arguments.String(ArgumentCards)))
+	case ActionChangeCardList:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.ChangeCardList(parseCardList(cmd.Arguments.String(ArgumentCards)))
+	case ActionChangePoolSize:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		n := cmd.Arguments.Int(ArgumentPoolSize)
+		err = trn.ChangePoolSize(n)
+	case ActionWaiveDecklists:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		err = trn.WaiveDecklists()
+	case ActionChangeTeamSize:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		n := cmd.Arguments.Int(ArgumentTeamSize)
+		err = trn.ChangeTeamSize(n)
+	case ActionRegisterTeam:
+		members := playerIDsArgument(cmd.Arguments, ArgumentMembers)
+		if !containsPlayer(members, accID) && !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("You can only register a Team you are a Member of"), isHtmlReq)
+			return
+		}
+		err = trn.RegisterTeam(cmd.Arguments.String(ArgumentName), members)
+	case ActionDropTeam:
+		tID := TeamID(cmd.Arguments.String(ArgumentTeam))
+		team := trn.getTeamByID(tID)
+		if team == nil {
+			team = trn.getWaitlistedTeam(tID)
+		}
+		if team == nil {
+			handleError(w, http.StatusNotFound, fmt.Errorf("Team not found"), isHtmlReq)
+			return
+		}
+		if !containsPlayer(team.Members, accID) && !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("You can only drop a Team you are a Member of"), isHtmlReq)
+			return
+		}
+		err = trn.DropTeam(team.ID)
+	case ActionChangePodSize:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		n := cmd.Arguments.Int(ArgumentPodSize)
+		err = trn.ChangePodSize(n)
+	case ActionChangePoints:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
+			return
+		}
+		var points []int
+		points, err = parsePoints(cmd.Arguments.String(ArgumentPoints))
+		if err != nil {
+			handleError(w, http.StatusBadRequest, fmt.Errorf("Placement Points have to be numbers"), isHtmlReq)
+			return
+		}
+		err = trn.ChangePlacementPoints(points)
+	case ActionReportPod:
+		pod := cmd.Arguments.Int(ArgumentPod)
+		if pod < 0 || pod >= len(trn.Pods) {
+			handleError(w, http.StatusNotFound, fmt.Errorf("Pod does not exist"), isHtmlReq)
+			return
+		}
+		if !containsPlayer(trn.Pods[pod].Players, accID) && !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("You can only report Pods you are playing in"), isHtmlReq)
+			return
+		}
+		err = trn.ReportPod(pod, playerIDsArgument(cmd.Arguments, ArgumentPlacements))
 	case ActionChangeMaxPlayers:
 		if !editable {
 			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
@@ -214,14 +579,7 @@ func (s *Server) handlePOSTTournament(w http.ResponseWriter, r *http.Request) {
 			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
 			return
 		}
-		switch trn.Format {
-		case "cube":
-			err = trn.handleEndPhaseCube()
-		case "":
-			err = fmt.Errorf("Can't proceed to next Phase: Format not set")
-		default:
-			err = fmt.Errorf("Format not recognized: %s", trn.Format)
-		}
+		err = trn.EndPhase()
 	case ActionDelete:
 		if !editable {
 			handleError(w, http.StatusForbidden, fmt.Errorf("Unable to edit Tournament: Insufficient Permissions"), isHtmlReq)
@@ -233,11 +591,51 @@ func (s *Server) handlePOSTTournament(w http.ResponseWriter, r *http.Request) {
 		g := cmd.Arguments.Int(ArgumentGame)
 		wnr := cmd.Arguments.String(ArgumentPlayerID)
 		draw := cmd.Arguments.Bool(ArgumentDraw)
-		err = trn.EndGame(m, g, PlayerID(wnr), draw)
+		if editable {
+			err = trn.EndGame(m, g, PlayerID(wnr), draw)
+		} else {
+			// players only report results, which their opponent has to confirm
+			err = trn.ReportResult(m, g, accID, PlayerID(wnr), draw)
+		}
 		if err != nil {
 			handleError(w, http.StatusInternalServerError, err, isHtmlReq)
 			return
 		}
+	case ActionCorrectGame:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Only Organizers can correct Results"), isHtmlReq)
+			return
+		}
+		m := cmd.Arguments.Int(ArgumentMatch)
+		g := cmd.Arguments.Int(ArgumentGame)
+		wnr := cmd.Arguments.String(ArgumentPlayerID)
+		draw := cmd.Arguments.Bool(ArgumentDraw)
+		err = trn.CorrectGame(m, g, PlayerID(wnr), draw, accID)
+	case ActionCorrectMatch:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Only Organizers can correct Results"), isHtmlReq)
+			return
+		}
+		m := cmd.Arguments.Int(ArgumentMatch)
+		wnr := cmd.Arguments.String(ArgumentPlayerID)
+		draw := cmd.Arguments.Bool(ArgumentDraw)
+		err = trn.CorrectMatch(m, PlayerID(wnr), draw, accID)
+	case ActionRecordInfraction:
+		if !editable {
+			handleError(w, http.StatusForbidden, fmt.Errorf("Only Organizers can record Infractions"), isHtmlReq)
+			return
+		}
+		m := cmd.Arguments.Int(ArgumentMatch)
+		pID := cmd.Arguments.String(ArgumentPlayerID)
+		penalty := cmd.Arguments.String(ArgumentPenalty)
+		reason := cmd.Arguments.String(ArgumentReason)
+		err = trn.RecordInfraction(m, PlayerID(pID), penalty, reason, accID)
+	case ActionConfirmResult:
+		m := cmd.Arguments.Int(ArgumentMatch)
+		err = trn.ConfirmResult(m, accID)
+	case ActionDisputeResult:
+		m := cmd.Arguments.Int(ArgumentMatch)
+		err = trn.DisputeResult(m, accID)
 	default:
 		err = fmt.Errorf("Action not recognized: %s", cmd.Action)
 	}
@@ -293,56 +691,120 @@ func (s *Server) handlePOSTTournaments(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func (trn *Tournament) handleEndPhaseCube() error {
+// EndPhase moves the tournament on to the next phase of its format.
+func (trn *Tournament) EndPhase() error {
+	if trn.Format == "" {
+		return fmt.Errorf("Can't proceed to next Phase: Format not set")
+	}
+	f, ok := formatByName(trn.Format)
+	if !ok {
+		return fmt.Errorf("Format not recognized: %s", trn.Format)
+	}
 	switch trn.Phase {
 	case PhaseInitialization:
 		if trn.Name == "" {
 			return fmt.Errorf("Can't proceed to next Phase: Name not set")
 		}
-		err = trn.ChangePhase(PhaseRegistration)
+		if trn.Pairing != "" && !containsString(f.Pairings(), trn.Pairing) {
+			return fmt.Errorf("Can't proceed to next Phase: Pairing %s not supported by Format %s", trn.Pairing, trn.Format)
+		}
+		if trn.TeamSize > 0 && (trn.TopCut > 0 || trn.Pairing == PairingDoubleElimination) {
+			return fmt.Errorf("Can't proceed to next Phase: Team Tournaments are played in Rounds only")
+		}
+		if trn.PodSize > 0 && (trn.TeamSize > 0 || trn.TopCut > 0 || trn.Pairing == PairingDoubleElimination) {
+			return fmt.Errorf("Can't proceed to next Phase: Pod Tournaments are played in Rounds only")
+		}
+		for _, p := range f.Phases() {
+			if p == PhasePoolOpening && (len(trn.CardList) == 0 || trn.PoolSize == 0) {
+				return fmt.Errorf("Can't proceed to next Phase: Card List and Pool Size have to be set")
+			}
+		}
 	case PhaseRegistration:
 		if len(trn.Participants) == 0 {
 			return fmt.Errorf("Can't proceed to next Phase: No Players registered")
 		}
-		err = trn.ChangePhase(PhaseDraft)
+		if trn.TeamSize > 0 && len(trn.Teams) < 2 {
+			return fmt.Errorf("Can't proceed to next Phase: At least 2 Teams have to be registered")
+		}
+	case PhaseCheckIn:
+		err = trn.dropNoShows()
 		if err != nil {
 			return err
 		}
-		err = trn.Begin()
 	case PhaseDraft:
-		err = trn.ChangePhase(PhaseRounds)
+	case PhasePoolOpening:
+	case PhaseDeckSubmission:
+		if !trn.DecklistsWaived {
+			for _, par := range trn.Participants {
+				if par.Dropped {
+					continue
+				}
+				if par.Deck == "" && len(par.DeckCards) == 0 {
+					return fmt.Errorf("Can't proceed to next Phase: Not all Players have submitted a Deck")
+				}
+			}
+		}
 	case PhaseRounds:
-		for _, mtc := range trn.Matches {
-			if !mtc.Ended {
-				return fmt.Errorf("Not all Matches have ended")
+		if !trn.allMatchesEnded() {
+			return fmt.Errorf("Not all Matches have ended")
+		}
+		if trn.Pairing == PairingDoubleElimination {
+			if trn.Champion == "" {
+				return fmt.Errorf("Bracket has not been decided yet")
 			}
+		} else if !trn.roundsComplete() {
+			return fmt.Errorf("Not all Rounds have been played")
 		}
-		err = trn.ChangePhase(PhaseEnded)
-		if err != nil {
-			return err
+	case PhasePlayoffs:
+		if trn.Champion == "" {
+			return fmt.Errorf("Playoffs have not been decided yet")
 		}
-		err = trn.Finish()
 	case PhaseEnded:
-		err = fmt.Errorf("Tournament has already ended")
+		return fmt.Errorf("Tournament has already ended")
 	default:
-		err = fmt.Errorf("Phase not recognized")
+		return fmt.Errorf("Phase not recognized")
+	}
+	next := trn.nextPhase(f)
+	if next == "" {
+		return fmt.Errorf("Phase %s is not part of Format %s", trn.Phase, trn.Format)
 	}
+	prev := trn.Phase
+	err = trn.ChangePhase(next)
 	if err != nil {
 		return err
 	}
+	// the tournament starts with the first phase after registration and check-in
+	if (prev == PhaseRegistration && next != PhaseCheckIn) || prev == PhaseCheckIn {
+		return trn.Begin()
+	}
+	if next == PhaseEnded {
+		return trn.Finish()
+	}
 	return nil
 }
 
-func switchPhase(p Phase, w http.ResponseWriter, r *http.Request, data interface{}) error {
-	switch p {
+func switchPhase(trn *Tournament, w http.ResponseWriter, r *http.Request, data interface{}) error {
+	switch trn.Phase {
 	case PhaseInitialization:
 		err = templ.ExecuteTemplate(w, "tournamentInitialization.html", data)
 	case PhaseRegistration:
 		err = templ.ExecuteTemplate(w, "tournamentRegistration.html", data)
+	case PhaseCheckIn:
+		err = templ.ExecuteTemplate(w, "tournamentCheckIn.html", data)
 	case PhaseDraft:
 		err = templ.ExecuteTemplate(w, "tournamentDraft.html", data)
+	case PhasePoolOpening:
+		err = templ.ExecuteTemplate(w, "tournamentPools.html", data)
+	case PhaseDeckSubmission:
+		err = templ.ExecuteTemplate(w, "tournamentDecks.html", data)
 	case PhaseRounds:
-		err = templ.ExecuteTemplate(w, "tournamentRoundRobin.html", data)
+		if trn.Pairing == PairingDoubleElimination {
+			err = templ.ExecuteTemplate(w, "tournamentBracket.html", data)
+		} else {
+			err = templ.ExecuteTemplate(w, "tournamentRoundRobin.html", data)
+		}
+	case PhasePlayoffs:
+		err = templ.ExecuteTemplate(w, "tournamentBracket.html", data)
 	case PhaseEnded:
 		err = templ.ExecuteTemplate(w, "tournamentEnded.html", data)
 	default:
@@ -360,6 +822,7 @@ func (trn *Tournament) permutatePlayers(eventTime time.Time) {
 	for i, randIndex := range perm {
 		trn.Participants[i].SeatIndex = randIndex
 	}
+	trn.assignDraftPods()
 }
 
 func (trn *Tournament) getParticipantByID(ID PlayerID) *Participant {
@@ -397,11 +860,41 @@ func (trn *Tournament) MakeParticipantsHyperItem(resolve hyper.ResolverFunc) (hy
 						Name:  "seatIndex",
 						Value: trn.Participants[i].SeatIndex,
 					},
+					{
+						Label: "Draft Pod",
+						Name:  "draftPod",
+						Value: trn.Participants[i].DraftPod,
+					},
+					{
+						Label: "Fixed Table",
+						Name:  "fixedTable",
+						Value: trn.Participants[i].FixedTable,
+					},
+					{
+						Label: "Checked In",
+						Name:  "checkedIn",
+						Value: trn.Participants[i].CheckedIn,
+					},
 					{
 						Label: "Deck",
 						Name:  "deck",
 						Value: trn.Participants[i].Deck,
 					},
+					{
+						Label: "Pool",
+						Name:  "pool",
+						Value: trn.Participants[i].Pool,
+					},
+					{
+						Label: "Deck Cards",
+						Name:  "deckCards",
+						Value: trn.Participants[i].DeckCards,
+					},
+					{
+						Label: "Points",
+						Name:  "points",
+						Value: trn.matchPoints(par.Player),
+					},
 					{
 						Label: "Matches",
 						Name:  "matches",
@@ -516,6 +1009,142 @@ func (trn *Tournament) MakeDetailedHyperItem(resolve hyper.ResolverFunc) hyper.I
 		Name:  "maxPlayers",
 		Value: trn.MaxPlayers,
 	}
+	pairingProp := hyper.Property{
+		Label: "Pairing",
+		Name:  "pairing",
+		Value: trn.Pairing,
+	}
+	draftPodsProp := hyper.Property{
+		Label: "Draft Pods",
+		Name:  "draftPods",
+		Value: trn.draftPodSeats(),
+	}
+	seatPairingProp := hyper.Property{
+		Label: "First Round Pairing",
+		Name:  "seatPairing",
+		Value: trn.seatPairing(),
+	}
+	numRoundsProp := hyper.Property{
+		Label: "Number of Rounds",
+		Name:  "numberOfRounds",
+		Value: trn.NumberOfRounds,
+	}
+	roundTimeProp := hyper.Property{
+		Label: "Round Time",
+		Name:  "roundTime",
+		Value: trn.RoundTime,
+	}
+	scheduleProps := hyper.Properties{
+		{
+			Label: "Registration Opens",
+			Name:  "registrationOpen",
+			Value: scheduledTime(trn.Schedule.RegistrationOpen),
+		},
+		{
+			Label: "Registration Closes",
+			Name:  "registrationClose",
+			Value: scheduledTime(trn.Schedule.RegistrationClose),
+		},
+		{
+			Label: "Scheduled Start",
+			Name:  "scheduledStart",
+			Value: scheduledTime(trn.Schedule.Start),
+		},
+	}
+	remainingTimeProp := hyper.Property{
+		Label: "Remaining Time",
+		Name:  "remainingTime",
+		Value: int(trn.remainingTime(time.Now().UTC()).Seconds()),
+	}
+	roundProp := hyper.Property{
+		Label: "Round",
+		Name:  "round",
+		Value: trn.currentRound(),
+	}
+	roundsProp := hyper.Property{
+		Label: "Rounds",
+		Name:  "rounds",
+		Value: trn.roundMatches(),
+	}
+	topCutProp := hyper.Property{
+		Label: "Top Cut",
+		Name:  "topCut",
+		Value: trn.TopCut,
+	}
+	pointsSystemProp := hyper.Property{
+		Label: "Points System",
+		Name:  "pointsSystem",
+		Value: trn.pointsSystem(),
+	}
+	tiebreakersProp := hyper.Property{
+		Label: "Tiebreakers",
+		Name:  "tiebreakers",
+		Value: trn.tiebreakerOrder(),
+	}
+	seedsProp := hyper.Property{
+		Label: "Seeds",
+		Name:  "seeds",
+		Value: trn.Seeds,
+	}
+	championProp := hyper.Property{
+		Label: "Champion",
+		Name:  "champion",
+		Value: trn.Champion,
+	}
+	cardListProp := hyper.Property{
+		Label: "Card List",
+		Name:  "cardList",
+		Value: trn.CardList,
+	}
+	poolSizeProp := hyper.Property{
+		Label: "Pool Size",
+		Name:  "poolSize",
+		Value: trn.PoolSize,
+	}
+	podSizeProp := hyper.Property{
+		Label: "Pod Size",
+		Name:  "podSize",
+		Value: trn.PodSize,
+	}
+	pointsProp := hyper.Property{
+		Label: "Placement Points",
+		Name:  "placementPoints",
+		Value: trn.PlacementPoints,
+	}
+	podsProp := hyper.Property{
+		Label: "Pods",
+		Name:  "pods",
+		Value: trn.Pods,
+	}
+	waitlistProp := hyper.Property{
+		Label: "Waitlist",
+		Name:  "waitlist",
+		Value: trn.Waitlist,
+	}
+	teamWaitlist := []string{}
+	for _, t := range trn.TeamWaitlist {
+		teamWaitlist = append(teamWaitlist, t.Name)
+	}
+	teamWaitlistProp := hyper.Property{
+		Label: "Team Waitlist",
+		Name:  "teamWaitlist",
+		Value: teamWaitlist,
+	}
+	infractionsProp := hyper.Property{
+		Label: "Infractions",
+		Name:  "infractions",
+		Value: trn.infractionViews(),
+	}
+	teamSizeProp := hyper.Property{
+		Label: "Team Size",
+		Name:  "teamSize",
+		Value: trn.TeamSize,
+	}
+	waivedProp := hyper.Property{
+		Label: "Decklists Waived",
+		Name:  "decklistsWaived",
+		Value: trn.DecklistsWaived,
+	}
 	//Actions
 	nameAct := hyper.Action{
 		Label:  "Change Name",
@@ -541,6 +1170,10 @@ func (trn *Tournament) MakeDetailedHyperItem(resolve hyper.ResolverFunc) hyper.I
 			},
 		},
 	}
+	formatOpts := hyper.SelectOptions{}
+	for _, f := range formatNames() {
+		formatOpts = append(formatOpts, hyper.SelectOption{Label: f, Value: f})
+	}
 	formatAct := hyper.Action{
 		Label:  "Change Format",
 		Rel:    ActionChangeFormat,
@@ -548,8 +1181,9 @@ func (trn *Tournament) MakeDetailedHyperItem(resolve hyper.ResolverFunc) hyper.I
 		Method: "POST",
 		Parameters: hyper.Parameters{
 			{
-				Name:        ArgumentFormat,
-				Placeholder: "New Format...",
+				Name:    ArgumentFormat,
+				Value:   trn.Format,
+				Options: formatOpts,
 			},
 		},
 	}
@@ -565,43 +1199,534 @@ func (trn *Tournament) MakeDetailedHyperItem(resolve hyper.ResolverFunc) hyper.I
 			},
 		},
 	}
-	phaseAct := hyper.Action{
-		Label:  "End Phase",
-		Rel:    ActionEndPhase,
-		Href:   resolve("./%s", trn.ID).String(),
-		Method: "POST",
+	pairingOpts := hyper.SelectOptions{}
+	for _, p := range pairings {
+		pairingOpts = append(pairingOpts, hyper.SelectOption{Label: p, Value: p})
 	}
-	deleteAct := hyper.Action{
-		Label:  "Delete",
-		Rel:    ActionDelete,
+	pairingAct := hyper.Action{
+		Label:  "Change Pairing",
+		Rel:    ActionChangePairing,
 		Href:   resolve("./%s", trn.ID).String(),
 		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:    ArgumentPairing,
+				Value:   trn.Pairing,
+				Options: pairingOpts,
+			},
+		},
 	}
-	registerAct := hyper.Action{
-		Label:  "Register Player",
-		Rel:    ActionRegisterPlayer,
+	seatPairingAct := hyper.Action{
+		Label:  "Change First Round Pairing",
+		Rel:    ActionChangeSeatPairing,
 		Href:   resolve("./%s", trn.ID).String(),
 		Method: "POST",
 		Parameters: hyper.Parameters{
 			{
-				Name: ArgumentPlayerID,
+				Name:  ArgumentSeatPairing,
+				Value: trn.seatPairing(),
+				Options: hyper.SelectOptions{
+					{Label: "Cross-Pairing", Value: SeatPairingCross},
+					{Label: "Neighbor Pairing", Value: SeatPairingNeighbor},
+					{Label: "Random Pairing", Value: SeatPairingRandom},
+				},
 			},
 		},
 	}
-	dropAct := hyper.Action{
-		Label:  "Drop Player",
-		Rel:    ActionDropPlayer,
+	roundsAct := hyper.Action{
+		Label:  "Change Number of Rounds",
+		Rel:    ActionChangeRounds,
 		Href:   resolve("./%s", trn.ID).String(),
 		Method: "POST",
 		Parameters: hyper.Parameters{
 			{
-				Name: ArgumentPlayerID,
+				Name:        ArgumentRounds,
+				Placeholder: "Number of Rounds (0 = automatic)",
 			},
 		},
 	}
-	endGameAct := hyper.Action{
-		Label:  "End Game",
-		Rel:    ActionEndGame,
+	roundTimeAct := hyper.Action{
+		Label:  "Change Round Time",
+		Rel:    ActionChangeRoundTime,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentRoundTime,
+				Placeholder: "Minutes per Round (0 = no limit)",
+			},
+		},
+	}
+	scheduleAct := hyper.Action{
+		Label:  "Change Schedule",
+		Rel:    ActionChangeSchedule,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:  ArgumentOpen,
+				Label: "Registration Opens",
+				Value: scheduledTime(trn.Schedule.RegistrationOpen),
+			},
+			{
+				Name:  ArgumentClose,
+				Label: "Registration Closes",
+				Value: scheduledTime(trn.Schedule.RegistrationClose),
+			},
+			{
+				Name:  ArgumentStart,
+				Label: "Start",
+				Value: scheduledTime(trn.Schedule.Start),
+			},
+		},
+	}
+	topCutAct := hyper.Action{
+		Label:  "Change Top Cut",
+		Rel:    ActionChangeTopCut,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentTopCut,
+				Placeholder: "Top Cut (0 = no Playoffs)",
+			},
+		},
+	}
+	ps := trn.pointsSystem()
+	pointsSystemAct := hyper.Action{
+		Label:  "Change Points System",
+		Rel:    ActionChangePointsSystem,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Label: "Win",
+				Name:  ArgumentWin,
+				Value: ps.Win,
+			},
+			{
+				Label: "Draw",
+				Name:  ArgumentDraw,
+				Value: ps.Draw,
+			},
+			{
+				Label: "Loss",
+				Name:  ArgumentLoss,
+				Value: ps.Loss,
+			},
+			{
+				Label: "Bye",
+				Name:  ArgumentBye,
+				Value: ps.Bye,
+			},
+		},
+	}
+	tiebreakersAct := hyper.Action{
+		Label:  "Change Tiebreakers",
+		Rel:    ActionChangeTiebreakers,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentTiebreakers,
+				Value:       strings.Join(trn.tiebreakerOrder(), ","),
+				Placeholder: "Tiebreakers in Order, e.g. omw,gw,ogw",
+			},
+		},
+	}
+	playDrawAct := hyper.Action{
+		Label:  "Choose Play/Draw",
+		Rel:    ActionChoosePlayDraw,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+			{
+				Name: ArgumentPlayerID,
+			},
+			{
+				Name: ArgumentPlay,
+			},
+		},
+	}
+	startRoundAct := hyper.Action{
+		Label:  "Start Round",
+		Rel:    ActionStartRound,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+	}
+	endRoundAct := hyper.Action{
+		Label:  "End Round",
+		Rel:    ActionEndRound,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+	}
+	startClockAct := hyper.Action{
+		Label:  "Start Clock",
+		Rel:    ActionStartClock,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+	}
+	callTimeAct := hyper.Action{
+		Label:  "Call Time",
+		Rel:    ActionCallTime,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+	}
+	concludeMatchAct := hyper.Action{
+		Label:  "Conclude Match",
+		Rel:    ActionConcludeMatch,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+			{
+				Name: ArgumentDraw,
+			},
+		},
+	}
+	intentionalDrawAct := hyper.Action{
+		Label:  "Intentional Draw",
+		Rel:    ActionIntentionalDraw,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+		},
+	}
+	assignTableAct := hyper.Action{
+		Label:  "Assign Table",
+		Rel:    ActionAssignTable,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+			{
+				Name:        ArgumentTable,
+				Placeholder: "Table...",
+			},
+		},
+	}
+	fixedTableAct := hyper.Action{
+		Label:  "Assign Fixed Table",
+		Rel:    ActionAssignFixedTable,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentPlayerID,
+			},
+			{
+				Name:        ArgumentTable,
+				Placeholder: "Table (0 = none)",
+			},
+		},
+	}
+	submitDeckAct := hyper.Action{
+		Label:  "Submit Deck",
+		Rel:    ActionSubmitDeck,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentPlayerID,
+			},
+			{
+				Name:        ArgumentDeck,
+				Placeholder: "Deck ID...",
+			},
+		},
+	}
+	cardListAct := hyper.Action{
+		Label:  "Change Card List",
+		Rel:    ActionChangeCardList,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentCards,
+				Placeholder: "One Card per Line...",
+				Value:       strings.Join(trn.CardList, "\n"),
+			},
+		},
+	}
+	poolSizeAct := hyper.Action{
+		Label:  "Change Pool Size",
+		Rel:    ActionChangePoolSize,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentPoolSize,
+				Placeholder: "Cards per Pool...",
+			},
+		},
+	}
+	buildDeckAct := hyper.Action{
+		Label:  "Build Deck",
+		Rel:    ActionBuildDeck,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentPlayerID,
+			},
+			{
+				Name:        ArgumentCards,
+				Placeholder: "One Card per Line...",
+			},
+		},
+	}
+	teamSizeAct := hyper.Action{
+		Label:  "Change Team Size",
+		Rel:    ActionChangeTeamSize,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:  ArgumentTeamSize,
+				Value: trn.TeamSize,
+				Options: hyper.SelectOptions{
+					{Label: "Individual", Value: 0},
+					{Label: "Two-Headed Giant", Value: TeamSizeTwoHeadedGiant},
+					{Label: "Trios", Value: TeamSizeTrios},
+				},
+			},
+		},
+	}
+	podSizeAct := hyper.Action{
+		Label:  "Change Pod Size",
+		Rel:    ActionChangePodSize,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:  ArgumentPodSize,
+				Value: trn.PodSize,
+				Options: hyper.SelectOptions{
+					{Label: "No Pods", Value: 0},
+					{Label: "3 Players", Value: 3},
+					{Label: "4 Players", Value: 4},
+					{Label: "5 Players", Value: 5},
+					{Label: "6 Players", Value: 6},
+				},
+			},
+		},
+	}
+	pointsAct := hyper.Action{
+		Label:  "Change Placement Points",
+		Rel:    ActionChangePoints,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentPoints,
+				Value:       formatPoints(trn.PlacementPoints),
+				Placeholder: "Points per Place, e.g. 4,2,1,0",
+			},
+		},
+	}
+	reportPodAct := hyper.Action{
+		Label:  "Report Pod",
+		Rel:    ActionReportPod,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentPod,
+			},
+			{
+				Name: ArgumentPlacements,
+			},
+		},
+	}
+	registerTeamAct := hyper.Action{
+		Label:  "Register Team",
+		Rel:    ActionRegisterTeam,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentName,
+				Placeholder: "Team Name...",
+			},
+			{
+				Name:        ArgumentMembers,
+				Placeholder: "Player IDs, separated by Commas...",
+			},
+		},
+	}
+	dropTeamAct := hyper.Action{
+		Label:  "Drop Team",
+		Rel:    ActionDropTeam,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentTeam,
+			},
+		},
+	}
+	waiveAct := hyper.Action{
+		Label:  "Start without all Decklists",
+		Rel:    ActionWaiveDecklists,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+	}
+	phaseAct := hyper.Action{
+		Label:  "End Phase",
+		Rel:    ActionEndPhase,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+	}
+	deleteAct := hyper.Action{
+		Label:  "Delete",
+		Rel:    ActionDelete,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+	}
+	registerAct := hyper.Action{
+		Label:  "Register Player",
+		Rel:    ActionRegisterPlayer,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentPlayerID,
+			},
+		},
+	}
+	registerGuestAct := hyper.Action{
+		Label:  "Register Guest",
+		Rel:    ActionRegisterGuest,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name:        ArgumentName,
+				Placeholder: "Guest Name",
+			},
+		},
+	}
+	dropAct := hyper.Action{
+		Label:  "Drop Player",
+		Rel:    ActionDropPlayer,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentPlayerID,
+			},
+		},
+	}
+	checkInAct := hyper.Action{
+		Label:  "Check In",
+		Rel:    ActionCheckIn,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentPlayerID,
+			},
+		},
+	}
+	correctGameAct := hyper.Action{
+		Label:  "Correct Game",
+		Rel:    ActionCorrectGame,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+			{
+				Name: ArgumentGame,
+			},
+			{
+				Name: ArgumentPlayerID,
+			},
+			{
+				Name: ArgumentDraw,
+			},
+		},
+	}
+	correctMatchAct := hyper.Action{
+		Label:  "Correct Match",
+		Rel:    ActionCorrectMatch,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+			{
+				Name: ArgumentPlayerID,
+			},
+			{
+				Name: ArgumentDraw,
+			},
+		},
+	}
+	recordInfractionAct := hyper.Action{
+		Label:  "Record Infraction",
+		Rel:    ActionRecordInfraction,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+			{
+				Name: ArgumentPlayerID,
+			},
+			{
+				Name: ArgumentPenalty,
+				Options: hyper.SelectOptions{
+					{Label: "Warning", Value: PenaltyWarning},
+					{Label: "Game Loss", Value: PenaltyGameLoss},
+					{Label: "Match Loss", Value: PenaltyMatchLoss},
+					{Label: "Disqualification", Value: PenaltyDisqualification},
+				},
+			},
+			{
+				Name:        ArgumentReason,
+				Placeholder: "Reason...",
+			},
+		},
+	}
+	confirmResultAct := hyper.Action{
+		Label:  "Confirm Result",
+		Rel:    ActionConfirmResult,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+		},
+	}
+	disputeResultAct := hyper.Action{
+		Label:  "Dispute Result",
+		Rel:    ActionDisputeResult,
+		Href:   resolve("./%s", trn.ID).String(),
+		Method: "POST",
+		Parameters: hyper.Parameters{
+			{
+				Name: ArgumentMatch,
+			},
+		},
+	}
+	endGameAct := hyper.Action{
+		Label:  "End Game",
+		Rel:    ActionEndGame,
 		Href:   resolve("./%s", trn.ID).String(),
 		Method: "POST",
 		Parameters: hyper.Parameters{
@@ -629,27 +1754,158 @@ func (trn *Tournament) MakeDetailedHyperItem(resolve hyper.ResolverFunc) hyper.I
 		res.AddProperty(formatProp)
 		res.AddProperty(g2wProp)
 		res.AddProperty(maxProp)
+		res.AddProperty(pairingProp)
+		res.AddProperty(seatPairingProp)
+		res.AddProperty(numRoundsProp)
+		res.AddProperty(roundTimeProp)
+		res.AddProperties(scheduleProps)
+		res.AddProperty(topCutProp)
+		res.AddProperty(pointsSystemProp)
+		res.AddProperty(tiebreakersProp)
+		res.AddProperty(cardListProp)
+		res.AddProperty(poolSizeProp)
+		res.AddProperty(teamSizeProp)
+		res.AddProperty(podSizeProp)
+		res.AddProperty(pointsProp)
 
 		res.AddAction(formatAct)
 		res.AddAction(nameAct)
 		res.AddAction(g2wAct)
 		res.AddAction(maxAct)
+		res.AddAction(pairingAct)
+		res.AddAction(seatPairingAct)
+		res.AddAction(roundsAct)
+		res.AddAction(roundTimeAct)
+		res.AddAction(scheduleAct)
+		res.AddAction(topCutAct)
+		res.AddAction(pointsSystemAct)
+		res.AddAction(tiebreakersAct)
+		res.AddAction(cardListAct)
+		res.AddAction(poolSizeAct)
+		res.AddAction(teamSizeAct)
+		res.AddAction(podSizeAct)
+		res.AddAction(pointsAct)
 		res.AddAction(phaseAct)
 	case PhaseRegistration:
 		res.AddProperty(formatProp)
-		res.AddAction(registerAct)
+		res.AddProperty(teamSizeProp)
+		res.AddProperty(maxProp)
+		res.AddProperty(waitlistProp)
+		res.AddProperty(teamWaitlistProp)
+		res.AddProperties(scheduleProps)
+		if trn.TeamSize > 0 {
+			res.AddAction(registerTeamAct)
+			res.AddAction(dropTeamAct)
+		} else {
+			res.AddAction(registerAct)
+			res.AddAction(registerGuestAct)
+			res.AddAction(dropAct)
+		}
+		res.AddAction(fixedTableAct)
+		res.AddAction(maxAct)
+		res.AddAction(scheduleAct)
+		res.AddAction(phaseAct)
+	case PhaseCheckIn:
+		res.AddProperty(formatProp)
+		res.AddProperty(startProp)
+		res.AddProperties(scheduleProps)
+		res.AddAction(checkInAct)
 		res.AddAction(dropAct)
+		if trn.TeamSize > 0 {
+			res.AddAction(dropTeamAct)
+		}
 		res.AddAction(phaseAct)
 	case PhaseDraft:
 		res.AddProperty(formatProp)
 		res.AddProperty(startProp)
+		res.AddProperty(draftPodsProp)
+		res.AddAction(phaseAct)
+	case PhasePoolOpening:
+		res.AddProperty(formatProp)
+		res.AddProperty(startProp)
+		res.AddProperty(poolSizeProp)
+		res.AddAction(phaseAct)
+	case PhaseDeckSubmission:
+		res.AddProperty(formatProp)
+		res.AddProperty(startProp)
+		res.AddProperty(waivedProp)
+		res.AddAction(submitDeckAct)
+		res.AddAction(buildDeckAct)
+		if !trn.DecklistsWaived {
+			res.AddAction(waiveAct)
+		}
 		res.AddAction(phaseAct)
 	case PhaseRounds:
 		res.AddProperty(matchesProp)
+		res.AddProperty(pairingProp)
+		res.AddProperty(teamSizeProp)
+		res.AddProperty(infractionsProp)
+		if trn.PodSize > 0 {
+			res.AddProperty(podsProp)
+			res.AddProperty(pointsProp)
+			res.AddAction(reportPodAct)
+		}
+		if trn.Pairing == PairingDoubleElimination {
+			res.AddProperty(seedsProp)
+			res.AddProperty(championProp)
+			res.AddAction(playDrawAct)
+		} else {
+			res.AddProperty(roundProp)
+			res.AddProperty(roundsProp)
+			res.AddProperty(roundTimeProp)
+			res.AddProperty(remainingTimeProp)
+			if round := trn.runningRound(); round != 0 {
+				res.AddAction(endRoundAct)
+				if r := trn.Rounds[round-1]; r.Deadline.IsZero() && trn.RoundTime > 0 {
+					res.AddAction(startClockAct)
+				} else if !r.Deadline.IsZero() && !r.TimeCalled {
+					res.AddAction(callTimeAct)
+				}
+				res.AddAction(concludeMatchAct)
+				res.AddAction(intentionalDrawAct)
+			} else if len(trn.Rounds) == 0 && trn.Matches != nil && !trn.pairsPerRound() {
+				// paired before rounds were recorded, all matches are open
+				res.AddAction(concludeMatchAct)
+				res.AddAction(intentionalDrawAct)
+			} else if trn.currentRound() < trn.totalRounds() {
+				res.AddAction(startRoundAct)
+			}
+		}
 
 		res.AddAction(endGameAct)
+		res.AddAction(confirmResultAct)
+		res.AddAction(disputeResultAct)
+		res.AddAction(correctGameAct)
+		res.AddAction(correctMatchAct)
+		res.AddAction(recordInfractionAct)
+		res.AddAction(assignTableAct)
+		res.AddAction(fixedTableAct)
+		res.AddAction(dropAct)
+		if trn.TeamSize > 0 {
+			res.AddAction(dropTeamAct)
+		}
+		res.AddAction(phaseAct)
+	case PhasePlayoffs:
+		res.AddProperty(matchesProp)
+		res.AddProperty(topCutProp)
+		res.AddProperty(seedsProp)
+		res.AddProperty(championProp)
+		res.AddProperty(infractionsProp)
+
+		res.AddAction(endGameAct)
+		res.AddAction(confirmResultAct)
+		res.AddAction(disputeResultAct)
+		res.AddAction(correctGameAct)
+		res.AddAction(correctMatchAct)
+		res.AddAction(playDrawAct)
+		res.AddAction(recordInfractionAct)
+		res.AddAction(assignTableAct)
+		res.AddAction(fixedTableAct)
+		res.AddAction(dropAct)
 		res.AddAction(phaseAct)
 	case PhaseEnded:
+		res.AddProperty(championProp)
+		res.AddProperty(roundsProp)
 		res.AddProperty(formatProp)
 		res.AddProperty(g2wProp)
 		res.AddProperty(matchesProp)
@@ -657,5 +1913,81 @@ func (trn *Tournament) MakeDetailedHyperItem(resolve hyper.ResolverFunc) hyper.I
 		res.AddProperty(endProp)
 	}
 
+	// actions specific to other formats are not offered
+	actions := hyper.Actions{}
+	for _, a := range res.Actions {
+		if trn.allows(a.Rel) {
+			actions = append(actions, a)
+		}
+	}
+	res.Actions = actions
+	return res
+}
+
+// playerIDsArgument returns the player IDs of a command argument, given either
+// as a comma separated list or as multiple values.
+func playerIDsArgument(args hyper.Arguments, key string) []PlayerID {
+	res := []PlayerID{}
+	for _, v := range stringsArgument(args, key) {
+		res = append(res, PlayerID(v))
+	}
 	return res
 }
+
+// stringsArgument returns the non-empty values of key, given either as multiple values
+// or separated by commas.
+func stringsArgument(args hyper.Arguments, key string) []string {
+	values := []string{}
+	switch v := args[key].(type) {
+	case []string:
+		values = v
+	case []interface{}:
+		for _, s := range v {
+			values = append(values, fmt.Sprintf("%v", s))
+		}
+	default:
+		values = strings.Split(args.String(key), ",")
+	}
+	res := []string{}
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// dateTimeLocal is the layout of HTML datetime-local inputs.
+const dateTimeLocal = "2006-01-02T15:04"
+
+// timeArgument returns the time of key, given either in RFC 3339 or as a
+// datetime-local value in server time. An empty value is the zero time.
+func timeArgument(args hyper.Arguments, key string) (time.Time, error) {
+	v := strings.TrimSpace(args.String(key))
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		t, err = time.ParseInLocation(dateTimeLocal, v, time.Local)
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid Time: %s", v)
+	}
+	return t.UTC(), nil
+}
+
+func scheduleArgument(args hyper.Arguments) (Schedule, error) {
+	res := Schedule{}
+	var err error
+	res.RegistrationOpen, err = timeArgument(args, ArgumentOpen)
+	if err != nil {
+		return res, err
+	}
+	res.RegistrationClose, err = timeArgument(args, ArgumentClose)
+	if err != nil {
+		return res, err
+	}
+	res.Start, err = timeArgument(args, ArgumentStart)
+	return res, err
+}
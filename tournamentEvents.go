@@ -56,6 +56,20 @@ type TournamentPlayerDropped struct {
 	Player     PlayerID     `json:"player"`
 }
 
+type TournamentPlayerWaitlisted struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Player     PlayerID     `json:"player"`
+}
+
+type TournamentWaitlistPromoted struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Player     PlayerID     `json:"player"`
+}
+
 type TournamentPhaseChanged struct {
 	ID         string       `json:"id"`
 	OccurredOn time.Time    `json:"occurred-on"`
@@ -77,10 +91,70 @@ type TournamentMaxPlayersChanged struct {
 	MaxPlayers int          `json:"maxplayers"`
 }
 
+type TournamentPairingChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Pairing    string       `json:"pairing"`
+}
+
+type TournamentSeatPairingChanged struct {
+	ID          string       `json:"id"`
+	OccurredOn  time.Time    `json:"occurred-on"`
+	Tournament  TournamentID `json:"tournament"`
+	SeatPairing string       `json:"seatPairing"`
+}
+
+type TournamentNumberOfRoundsChanged struct {
+	ID             string       `json:"id"`
+	OccurredOn     time.Time    `json:"occurred-on"`
+	Tournament     TournamentID `json:"tournament"`
+	NumberOfRounds int          `json:"numberOfRounds"`
+}
+
+type TournamentRoundTimeChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	RoundTime  int          `json:"roundTime"`
+}
+
+type TournamentPlayerCheckedIn struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Player     PlayerID     `json:"player"`
+}
+
+type TournamentScheduleChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Schedule   Schedule     `json:"schedule"`
+}
+
 type TournamentMatchesCreated struct {
+	ID          string        `json:"id"`
+	OccurredOn  time.Time     `json:"occurred-on"`
+	Tournament  TournamentID  `json:"tournament"`
+	Round       int           `json:"round,omitempty"`
+	SeatPairing string        `json:"seatPairing,omitempty"`
+	Pairs       [][2]PlayerID `json:"pairs,omitempty"`
+	TeamPairs   [][2]TeamID   `json:"teamPairs,omitempty"`
+}
+
+type TournamentRoundStarted struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Round      int          `json:"round"`
+}
+
+type TournamentRoundEnded struct {
 	ID         string       `json:"id"`
 	OccurredOn time.Time    `json:"occurred-on"`
 	Tournament TournamentID `json:"tournament"`
+	Round      int          `json:"round"`
 }
 
 type TournamentMatchEnded struct {
@@ -102,6 +176,47 @@ type TournamentGameEnded struct {
 	Draw       bool         `json:"draw"`
 }
 
+type TournamentTopCutChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	TopCut     int          `json:"topCut"`
+}
+
+type TournamentPlayoffsCreated struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Seeds      []PlayerID   `json:"seeds"`
+}
+
+type TournamentBracketMatchCreated struct {
+	ID           string       `json:"id"`
+	OccurredOn   time.Time    `json:"occurred-on"`
+	Tournament   TournamentID `json:"tournament"`
+	Bracket      string       `json:"bracket"`
+	BracketRound int          `json:"bracketRound"`
+	Slot         int          `json:"slot"`
+	Player1      PlayerID     `json:"player1"`
+	Player2      PlayerID     `json:"player2"`
+}
+
+type TournamentPlayDrawChosen struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"` //index
+	Player     PlayerID     `json:"player"`
+	Play       bool         `json:"play"`
+}
+
+type TournamentChampionDetermined struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Player     PlayerID     `json:"player"`
+}
+
 type TournamentGamesToWinChanged struct {
 	ID         string       `json:"id"`
 	OccurredOn time.Time    `json:"occurred-on"`
@@ -109,6 +224,241 @@ type TournamentGamesToWinChanged struct {
 	GamesToWin int          `json:"gamesToWin"`
 }
 
+type TournamentDeckSubmitted struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Player     PlayerID     `json:"player"`
+	Deck       DeckID       `json:"deck"`
+}
+
+type TournamentDecklistsWaived struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+}
+
+type TournamentCardListChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Cards      []string     `json:"cards"`
+}
+
+type TournamentPoolSizeChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	PoolSize   int          `json:"poolSize"`
+}
+
+type TournamentPoolsOpened struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Seed       int64        `json:"seed"`
+}
+
+type TournamentDeckBuilt struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Player     PlayerID     `json:"player"`
+	Cards      []string     `json:"cards"`
+}
+
+type TournamentTeamSizeChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	TeamSize   int          `json:"teamSize"`
+}
+
+type TournamentTeamRegistered struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Team       TeamID       `json:"team"`
+	Name       string       `json:"name"`
+	Members    []PlayerID   `json:"members"`
+}
+
+type TournamentTeamWaitlisted struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Team       TeamID       `json:"team"`
+	Name       string       `json:"name"`
+	Members    []PlayerID   `json:"members"`
+}
+
+type TournamentTeamWaitlistPromoted struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Team       TeamID       `json:"team"`
+}
+
+type TournamentTeamDropped struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Team       TeamID       `json:"team"`
+}
+
+type TournamentPodSizeChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	PodSize    int          `json:"podSize"`
+}
+
+type TournamentPlacementPointsChanged struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Points     []int        `json:"points"`
+}
+
+type TournamentPodEnded struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Pod        int          `json:"pod"`
+	Placements []PlayerID   `json:"placements"`
+}
+
+type TournamentByeAwarded struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Round      int          `json:"round"`
+	Player     PlayerID     `json:"player"`
+}
+
+type TournamentPointsSystemChanged struct {
+	ID           string       `json:"id"`
+	OccurredOn   time.Time    `json:"occurred-on"`
+	Tournament   TournamentID `json:"tournament"`
+	PointsSystem PointsSystem `json:"pointsSystem"`
+}
+
+type TournamentTiebreakersChanged struct {
+	ID          string       `json:"id"`
+	OccurredOn  time.Time    `json:"occurred-on"`
+	Tournament  TournamentID `json:"tournament"`
+	Tiebreakers []string     `json:"tiebreakers"`
+}
+
+type TournamentResultReported struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	Game       int          `json:"game"`
+	Reporter   PlayerID     `json:"reporter"`
+	Winner     PlayerID     `json:"winner"`
+	Draw       bool         `json:"draw"`
+}
+
+type TournamentResultConfirmed struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	Player     PlayerID     `json:"player"`
+}
+
+type TournamentResultDisputed struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	Player     PlayerID     `json:"player"`
+}
+
+type TournamentGameCorrected struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	Game       int          `json:"game"`
+	OldWinner  PlayerID     `json:"oldWinner"`
+	OldDraw    bool         `json:"oldDraw"`
+	Winner     PlayerID     `json:"winner"`
+	Draw       bool         `json:"draw"`
+	Organizer  PlayerID     `json:"organizer"`
+}
+
+type TournamentMatchCorrected struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	OldWinner  PlayerID     `json:"oldWinner"`
+	OldDraw    bool         `json:"oldDraw"`
+	Winner     PlayerID     `json:"winner"`
+	Draw       bool         `json:"draw"`
+	Organizer  PlayerID     `json:"organizer"`
+}
+
+type TournamentInfractionRecorded struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	Player     PlayerID     `json:"player"`
+	Penalty    string       `json:"penalty"`
+	Reason     string       `json:"reason"`
+	Judge      PlayerID     `json:"judge"`
+}
+
+type TournamentRoundClockStarted struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Round      int          `json:"round"`
+	RoundTime  int          `json:"roundTime"`
+}
+
+type TournamentTimeCalled struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Round      int          `json:"round"`
+}
+
+type TournamentDrawOffered struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	Player     PlayerID     `json:"player"`
+}
+
+type TournamentIntentionalDrawRecorded struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+}
+
+type TournamentTableAssigned struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Match      int          `json:"match"`
+	Table      int          `json:"table"`
+}
+
+type TournamentFixedTableAssigned struct {
+	ID         string       `json:"id"`
+	OccurredOn time.Time    `json:"occurred-on"`
+	Tournament TournamentID `json:"tournament"`
+	Player     PlayerID     `json:"player"`
+	Table      int          `json:"table"`
+}
+
 func NewTournament(s *Server) *Tournament {
 	return &Tournament{
 		Server:         s,
@@ -191,286 +541,1954 @@ func (trn *Tournament) ChangePhase(p Phase) error {
 		Phase:      p,
 	})
 	if p == PhaseRounds {
-		err = trn.CreateMatches()
+		if trn.Pairing == PairingDoubleElimination {
+			err = trn.CreateMatches()
+		} else {
+			err = trn.StartRound()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if p == PhasePoolOpening {
+		err = trn.OpenPools()
+		if err != nil {
+			return err
+		}
+	}
+	if p == PhasePlayoffs {
+		err = trn.CreatePlayoffs()
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("Event: Tournament %v: Phase Changed To %v\n", trn.ID, p)
+	return nil
+}
+
+func (trn *Tournament) ChangeFormat(f string) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if f == "" {
+		return fmt.Errorf("Format not specified")
+	}
+	if _, ok := formatByName(f); !ok {
+		return fmt.Errorf("Format not recognized: %s", f)
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Format is not allowed in this Phase")
+	}
+	if trn.Format == f {
+		return nil
+	}
+	trn.Apply(TournamentFormatChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Format:     f,
+	})
+	log.Printf("Event: Tournament %v: Format Changed To %s\n", trn.ID, f)
+	return nil
+}
+
+func (trn *Tournament) ChangePairing(p string) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if !isPairingValid(p) {
+		return fmt.Errorf("Pairing not recognized: %s", p)
+	}
+	if f, ok := formatByName(trn.Format); ok && !containsString(f.Pairings(), p) {
+		return fmt.Errorf("Pairing %s not supported by Format %s", p, trn.Format)
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Pairing is not allowed in this Phase")
+	}
+	if trn.Pairing == p {
+		return nil
+	}
+	trn.Apply(TournamentPairingChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Pairing:    p,
+	})
+	log.Printf("Event: Tournament %v: Pairing Changed To %s\n", trn.ID, p)
+	return nil
+}
+
+func (trn *Tournament) ChangeSeatPairing(p string) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if !isSeatPairingValid(p) {
+		return fmt.Errorf("First Round Pairing not recognized: %s", p)
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing First Round Pairing is not allowed in this Phase")
+	}
+	if trn.seatPairing() == p {
+		return nil
+	}
+	trn.Apply(TournamentSeatPairingChanged{
+		ID:          uuid.MakeV4(),
+		OccurredOn:  time.Now().UTC(),
+		Tournament:  trn.ID,
+		SeatPairing: p,
+	})
+	log.Printf("Event: Tournament %v: First Round Pairing Changed To %s\n", trn.ID, p)
+	return nil
+}
+
+func (trn *Tournament) ChangeNumberOfRounds(n int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if n < 0 {
+		return fmt.Errorf("Number of Rounds may not be negative")
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Number of Rounds is not allowed in this Phase")
+	}
+	trn.Apply(TournamentNumberOfRoundsChanged{
+		ID:             uuid.MakeV4(),
+		OccurredOn:     time.Now().UTC(),
+		Tournament:     trn.ID,
+		NumberOfRounds: n,
+	})
+	log.Printf("Event: Tournament %v: Number of Rounds changed to %d\n", trn.ID, n)
+	return nil
+}
+
+func (trn *Tournament) ChangeRoundTime(minutes int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if minutes < 0 {
+		return fmt.Errorf("Round Time may not be negative")
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Round Time is not allowed in this Phase")
+	}
+	trn.Apply(TournamentRoundTimeChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		RoundTime:  minutes,
+	})
+	log.Printf("Event: Tournament %v: Round Time changed to %d minutes\n", trn.ID, minutes)
+	return nil
+}
+
+func (trn *Tournament) ChangeSchedule(s Schedule) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Phase != PhaseInitialization && trn.Phase != PhaseRegistration {
+		return fmt.Errorf("Changing the Schedule is not allowed in this Phase")
+	}
+	if !s.RegistrationOpen.IsZero() && !s.RegistrationClose.IsZero() && !s.RegistrationOpen.Before(s.RegistrationClose) {
+		return fmt.Errorf("Registration has to open before it closes")
+	}
+	if !s.RegistrationClose.IsZero() && !s.Start.IsZero() && s.Start.Before(s.RegistrationClose) {
+		return fmt.Errorf("Tournament may not start before Registration closes")
+	}
+	if !s.RegistrationOpen.IsZero() && !s.Start.IsZero() && !s.RegistrationOpen.Before(s.Start) {
+		return fmt.Errorf("Registration has to open before the Tournament starts")
+	}
+	trn.Apply(TournamentScheduleChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Schedule:   s,
+	})
+	log.Printf("Event: Tournament %v: Schedule changed\n", trn.ID)
+	return nil
+}
+
+func (trn *Tournament) ChangeTopCut(n int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if n != 0 && (n < 2 || !isPowerOfTwo(n)) {
+		return fmt.Errorf("Top Cut has to be a power of 2")
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Top Cut is not allowed in this Phase")
+	}
+	trn.Apply(TournamentTopCutChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		TopCut:     n,
+	})
+	log.Printf("Event: Tournament %v: Top Cut changed to %d\n", trn.ID, n)
+	return nil
+}
+
+func (trn *Tournament) ChangePointsSystem(ps PointsSystem) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if ps.Win <= 0 {
+		return fmt.Errorf("A Win has to be worth at least 1 Point")
+	}
+	if ps.Draw < 0 || ps.Loss < 0 || ps.Bye < 0 {
+		return fmt.Errorf("Points may not be negative")
+	}
+	if ps.Draw > ps.Win || ps.Loss > ps.Draw {
+		return fmt.Errorf("A Win has to be worth at least a Draw and a Draw at least a Loss")
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Points System is not allowed in this Phase")
+	}
+	trn.Apply(TournamentPointsSystemChanged{
+		ID:           uuid.MakeV4(),
+		OccurredOn:   time.Now().UTC(),
+		Tournament:   trn.ID,
+		PointsSystem: ps,
+	})
+	log.Printf("Event: Tournament %v: Points System changed to %d/%d/%d/%d\n", trn.ID, ps.Win, ps.Draw, ps.Loss, ps.Bye)
+	return nil
+}
+
+func (trn *Tournament) ChangeTiebreakers(list []string) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	err = validateTiebreakers(list)
+	if err != nil {
+		return err
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Tiebreakers is not allowed in this Phase")
+	}
+	trn.Apply(TournamentTiebreakersChanged{
+		ID:          uuid.MakeV4(),
+		OccurredOn:  time.Now().UTC(),
+		Tournament:  trn.ID,
+		Tiebreakers: list,
+	})
+	log.Printf("Event: Tournament %v: Tiebreakers changed to %v\n", trn.ID, list)
+	return nil
+}
+
+func (trn *Tournament) ChangeCardList(cards []string) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if len(cards) == 0 {
+		return fmt.Errorf("Card List is empty")
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Card List is not allowed in this Phase")
+	}
+	trn.Apply(TournamentCardListChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Cards:      cards,
+	})
+	log.Printf("Event: Tournament %v: Card List changed to %d Cards\n", trn.ID, len(cards))
+	return nil
+}
+
+func (trn *Tournament) ChangePoolSize(n int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if n <= 0 {
+		return fmt.Errorf("Pool Size has to be positive")
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Pool Size is not allowed in this Phase")
+	}
+	if trn.PoolSize == n {
+		return nil
+	}
+	trn.Apply(TournamentPoolSizeChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		PoolSize:   n,
+	})
+	log.Printf("Event: Tournament %v: Pool Size changed to %d\n", trn.ID, n)
+	return nil
+}
+
+func (trn *Tournament) ChangeTeamSize(n int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if !isTeamSizeValid(n) {
+		return fmt.Errorf("Team Size has to be %d or %d", TeamSizeTwoHeadedGiant, TeamSizeTrios)
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Team Size is not allowed in this Phase")
+	}
+	if trn.TeamSize == n {
+		return nil
+	}
+	trn.Apply(TournamentTeamSizeChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		TeamSize:   n,
+	})
+	log.Printf("Event: Tournament %v: Team Size changed to %d\n", trn.ID, n)
+	return nil
+}
+
+func (trn *Tournament) ChangePodSize(n int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if n != 0 && n < 3 {
+		return fmt.Errorf("A Pod needs at least 3 Players")
+	}
+	if n > 6 {
+		return fmt.Errorf("A Pod can have at most 6 Players")
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Pod Size is not allowed in this Phase")
+	}
+	if trn.PodSize == n {
+		return nil
+	}
+	trn.Apply(TournamentPodSizeChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		PodSize:    n,
+	})
+	log.Printf("Event: Tournament %v: Pod Size changed to %d\n", trn.ID, n)
+	return nil
+}
+
+func (trn *Tournament) ChangePlacementPoints(points []int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if len(points) == 0 {
+		return fmt.Errorf("Placement Points not specified")
+	}
+	for i := 1; i < len(points); i++ {
+		if points[i] > points[i-1] {
+			return fmt.Errorf("Placement Points may not increase with lower Places")
+		}
+	}
+	if trn.Phase != PhaseInitialization {
+		return fmt.Errorf("Changing Placement Points is not allowed in this Phase")
+	}
+	trn.Apply(TournamentPlacementPointsChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Points:     points,
+	})
+	log.Printf("Event: Tournament %v: Placement Points changed to %v\n", trn.ID, points)
+	return nil
+}
+
+func (trn *Tournament) ChangeMaxPlayers(n int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if n <= 1 {
+		return fmt.Errorf("MaxPlayers need to be at least 2")
+	}
+	if n < len(trn.Participants) {
+		return fmt.Errorf("MaxPlayers may not be lower than the number of registered Players")
+	}
+	trn.Apply(TournamentMaxPlayersChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		MaxPlayers: n,
+	})
+	log.Printf("Event: Tournament %v: MaxPlayers changed to %d\n", trn.ID, n)
+	return trn.promoteWaitlist()
+}
+
+func (trn *Tournament) ChangeGamesToWin(n int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if n <= 0 {
+		return fmt.Errorf("Games To Win has to be at least 1")
+	}
+	trn.Apply(TournamentGamesToWinChanged{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		GamesToWin: n,
+	})
+	log.Printf("Event: Tournament %v: GamesToWin changed to %d\n", trn.ID, n)
+	return nil
+}
+
+func (trn *Tournament) RegisterPlayer(pID PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if pID == "" {
+		return fmt.Errorf("No Player specified")
+	}
+	if trn.isPlayerRegistered(pID) {
+		return fmt.Errorf("Player already registered")
+	}
+	err = trn.checkPlayerRegistration()
+	if err != nil {
+		return err
+	}
+	if trn.isWaitlisted(pID) {
+		return fmt.Errorf("Player is already on the Waitlist")
+	}
+	plr, err := LoadPlayer(trn.Server, pID)
+	if err != nil {
+		return err
+	}
+	if trn.isFull() {
+		trn.Apply(TournamentPlayerWaitlisted{
+			ID:         uuid.MakeV4(),
+			OccurredOn: time.Now().UTC(),
+			Tournament: trn.ID,
+			Player:     pID,
+		})
+		log.Printf("Event: Tournament %v: Player %v Waitlisted\n", trn.ID, pID)
+		return nil
+	}
+	err = plr.RegisterTournament(trn.ID)
+	if err != nil {
+		return err
+	}
+	err = plr.Save(trn.Server.es, nil)
+	if err != nil {
+		return err
+	}
+	trn.Apply(TournamentPlayerRegistered{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Player:     pID,
+	})
+	log.Printf("Event: Tournament %v: Player %v Registered\n", trn.ID, pID)
+	return nil
+}
+
+// checkPlayerRegistration returns why single Players can not register at the moment, if so.
+func (trn *Tournament) checkPlayerRegistration() error {
+	if trn.Phase != PhaseRegistration {
+		return fmt.Errorf("Not in registration phase")
+	}
+	if trn.TeamSize > 0 {
+		return fmt.Errorf("Players have to register as a Team")
+	}
+	return nil
+}
+
+// promoteWaitlist registers waitlisted players and teams in order for as long as there is room.
+func (trn *Tournament) promoteWaitlist() error {
+	for trn.Phase == PhaseRegistration && len(trn.TeamWaitlist) > 0 && trn.hasRoomFor(len(trn.TeamWaitlist[0].Members)) {
+		tID := trn.TeamWaitlist[0].ID
+		plrs, err := LoadPlayers(trn.Server, trn.TeamWaitlist[0].Members)
+		if err != nil {
+			return err
+		}
+		team, err := LoadTeam(trn.Server.es, tID)
+		if err != nil {
+			return err
+		}
+		err = trn.enrollTeam(team, plrs)
+		if err != nil {
+			return err
+		}
+		trn.Apply(TournamentTeamWaitlistPromoted{
+			ID:         uuid.MakeV4(),
+			OccurredOn: time.Now().UTC(),
+			Tournament: trn.ID,
+			Team:       tID,
+		})
+		log.Printf("Event: Tournament %v: Team %v promoted from the Waitlist\n", trn.ID, tID)
+	}
+	for trn.Phase == PhaseRegistration && len(trn.Waitlist) > 0 && !trn.isFull() {
+		pID := trn.Waitlist[0]
+		plr, err := LoadPlayer(trn.Server, pID)
+		if err != nil {
+			return err
+		}
+		err = plr.RegisterTournament(trn.ID)
+		if err != nil {
+			return err
+		}
+		err = plr.Save(trn.Server.es, nil)
+		if err != nil {
+			return err
+		}
+		trn.Apply(TournamentWaitlistPromoted{
+			ID:         uuid.MakeV4(),
+			OccurredOn: time.Now().UTC(),
+			Tournament: trn.ID,
+			Player:     pID,
+		})
+		log.Printf("Event: Tournament %v: Player %v promoted from the Waitlist\n", trn.ID, pID)
+	}
+	return nil
+}
+
+func (trn *Tournament) DropPlayer(pID PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if pID == "" {
+		return fmt.Errorf("No Player specified")
+	}
+	if trn.isWaitlisted(pID) {
+		trn.Apply(TournamentPlayerDropped{
+			ID:         uuid.MakeV4(),
+			OccurredOn: time.Now().UTC(),
+			Tournament: trn.ID,
+			Player:     pID,
+		})
+		log.Printf("Event: Tournament %v: Player %v left the Waitlist\n", trn.ID, pID)
+		return nil
+	}
+	if team := trn.waitlistedTeamOf(pID); team != nil {
+		return trn.DropTeam(team.ID)
+	}
+	if !trn.isPlayerRegistered(pID) {
+		return fmt.Errorf("Player is not registered")
+	}
+	if team := trn.teamOf(pID); team != nil {
+		// a member dropping forfeits for the whole team
+		return trn.DropTeam(team.ID)
+	}
+	if trn.Phase == PhaseEnded {
+		return fmt.Errorf("Tournament has already ended")
+	}
+	if trn.isDropped(pID) {
+		return fmt.Errorf("Player has already dropped")
+	}
+	forfeited := trn.forfeitableMatches(pID)
+	trn.Apply(TournamentPlayerDropped{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Player:     pID,
+	})
+	log.Printf("Event: Tournament %v: Player %v Dropped\n", trn.ID, pID)
+	err = trn.promoteWaitlist()
+	if err != nil {
+		return err
+	}
+	return trn.concludeForfeits(forfeited)
+}
+
+// concludeForfeits tracks the results of the given forfeited matches and advances their brackets.
+func (trn *Tournament) concludeForfeits(forfeited []int) error {
+	for _, i := range forfeited {
+		if !trn.Matches[i].Ended {
+			continue
+		}
+		err = trn.trackMatch(i)
+		if err != nil {
+			return err
+		}
+		if trn.Matches[i].Bracket != "" {
+			err = trn.advanceBracket(i)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) CheckIn(pID PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Phase != PhaseCheckIn {
+		return fmt.Errorf("Checking in is not allowed in this Phase")
+	}
+	par := trn.getParticipantByID(pID)
+	if par == nil {
+		return fmt.Errorf("Player is not registered")
+	}
+	if par.CheckedIn {
+		return fmt.Errorf("Player has already checked in")
+	}
+	trn.Apply(TournamentPlayerCheckedIn{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Player:     pID,
+	})
+	log.Printf("Event: Tournament %v: Player %v checked in\n", trn.ID, pID)
+	return nil
+}
+
+// dropNoShows drops all participants who have not checked in.
+func (trn *Tournament) dropNoShows() error {
+	noShows := []PlayerID{}
+	for _, par := range trn.Participants {
+		if par.CheckedIn {
+			continue
+		}
+		if t := trn.teamOf(par.Player); t != nil {
+			return fmt.Errorf("Can't proceed to next Phase: Not all Members of Team %s have checked in", t.Name)
+		}
+		noShows = append(noShows, par.Player)
+	}
+	if len(noShows) == len(trn.Participants) {
+		return fmt.Errorf("Can't proceed to next Phase: No Players checked in")
+	}
+	for _, pID := range noShows {
+		err := trn.DropPlayer(pID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) RegisterTeam(name string, members []PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Phase != PhaseRegistration {
+		return fmt.Errorf("Not in registration phase")
+	}
+	if trn.TeamSize == 0 {
+		return fmt.Errorf("Tournament is not played in Teams")
+	}
+	if name == "" {
+		return fmt.Errorf("Team Name not specified")
+	}
+	for _, t := range trn.Teams {
+		if t.Name == name {
+			return fmt.Errorf("Team Name already taken")
+		}
+	}
+	for _, t := range trn.TeamWaitlist {
+		if t.Name == name {
+			return fmt.Errorf("Team Name already taken")
+		}
+	}
+	if len(members) != trn.TeamSize {
+		return fmt.Errorf("A Team needs exactly %d Members", trn.TeamSize)
+	}
+	for i, pID := range members {
+		if containsPlayer(members[:i], pID) {
+			return fmt.Errorf("Player %v is listed twice", pID)
+		}
+		if trn.isPlayerRegistered(pID) {
+			return fmt.Errorf("Player %v already registered", pID)
+		}
+		if trn.waitlistedTeamOf(pID) != nil {
+			return fmt.Errorf("Player %v is already on the Waitlist", pID)
+		}
+	}
+	plrs, err := LoadPlayers(trn.Server, members)
+	if err != nil {
+		return err
+	}
+	tID := TeamID(uuid.MakeV4())
+	team := NewTeam()
+	err = team.Create(tID, name, members)
+	if err != nil {
+		return err
+	}
+	if !trn.hasRoomFor(len(members)) {
+		err = team.Save(trn.Server.es, nil)
+		if err != nil {
+			return err
+		}
+		trn.Apply(TournamentTeamWaitlisted{
+			ID:         uuid.MakeV4(),
+			OccurredOn: time.Now().UTC(),
+			Tournament: trn.ID,
+			Team:       tID,
+			Name:       name,
+			Members:    members,
+		})
+		log.Printf("Event: Tournament %v: Team %v Waitlisted\n", trn.ID, tID)
+		return nil
+	}
+	err = trn.enrollTeam(team, plrs)
+	if err != nil {
+		return err
+	}
+	trn.Apply(TournamentTeamRegistered{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Team:       tID,
+		Name:       name,
+		Members:    members,
+	})
+	log.Printf("Event: Tournament %v: Team %v Registered\n", trn.ID, tID)
+	return nil
+}
+
+// enrollTeam registers the tournament with the team and each of its members.
+func (trn *Tournament) enrollTeam(team *Team, plrs []*Player) error {
+	for _, plr := range plrs {
+		err := plr.RegisterTournament(trn.ID)
+		if err != nil {
+			return err
+		}
+		err = plr.Save(trn.Server.es, nil)
+		if err != nil {
+			return err
+		}
+	}
+	err := team.RegisterTournament(trn.ID)
+	if err != nil {
+		return err
+	}
+	return team.Save(trn.Server.es, nil)
+}
+
+func (trn *Tournament) DropTeam(tID TeamID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.getWaitlistedTeam(tID) != nil {
+		trn.Apply(TournamentTeamDropped{
+			ID:         uuid.MakeV4(),
+			OccurredOn: time.Now().UTC(),
+			Tournament: trn.ID,
+			Team:       tID,
+		})
+		log.Printf("Event: Tournament %v: Team %v left the Waitlist\n", trn.ID, tID)
+		return nil
+	}
+	team := trn.getTeamByID(tID)
+	if team == nil {
+		return fmt.Errorf("Team is not registered")
+	}
+	if trn.Phase == PhaseEnded {
+		return fmt.Errorf("Tournament has already ended")
+	}
+	if team.Dropped {
+		return fmt.Errorf("Team has already dropped")
+	}
+	forfeited := trn.forfeitableMatches(team.Members...)
+	trn.Apply(TournamentTeamDropped{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Team:       tID,
+	})
+	log.Printf("Event: Tournament %v: Team %v Dropped\n", trn.ID, tID)
+	err = trn.promoteWaitlist()
+	if err != nil {
+		return err
+	}
+	return trn.concludeForfeits(forfeited)
+}
+
+func (trn *Tournament) Begin() error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Start != "" {
+		return fmt.Errorf("Tournament already started")
+	}
+	trn.Apply(TournamentStarted{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Start:      time.Now().UTC(),
+	})
+	log.Printf("Event: Tournament %v: Started\n", trn.ID)
+	return nil
+}
+
+func (trn *Tournament) Finish() error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Start == "" {
+		return fmt.Errorf("Tournament has not started yet")
+	}
+	if trn.End != "" {
+		return fmt.Errorf("Tournament has already ended")
+	}
+	trn.Apply(TournamentEnded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		End:        time.Now().UTC(),
+	})
+	log.Printf("Event: Tournament %v: Ended\n", trn.ID)
+	return nil
+}
+
+func (trn *Tournament) CreateMatches() error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Phase != PhaseRounds {
+		return fmt.Errorf("Not in rounds phase")
+	}
+	if !trn.pairsPerRound() && trn.Matches != nil {
+		return fmt.Errorf("Tournament already has matches")
+	}
+	if trn.Pairing == PairingDoubleElimination && trn.TeamSize > 0 {
+		return fmt.Errorf("Double Elimination is not supported for Teams")
+	}
+	if trn.Pairing == PairingDoubleElimination && len(trn.activePlayers()) < 2 {
+		return fmt.Errorf("Double Elimination requires at least 2 Players")
+	}
+	round := trn.currentRound() + 1
+	if round > trn.totalRounds() {
+		return fmt.Errorf("All Rounds have been played")
+	}
+	if !trn.allMatchesEnded() {
+		return fmt.Errorf("Not all Matches have ended")
+	}
+	// the seat pairing is recorded so that replaying the matches does not depend on later defaults
+	seatPairing := ""
+	if round == 1 && trn.seated() && trn.PodSize == 0 && trn.TeamSize == 0 && trn.Pairing != PairingDoubleElimination {
+		seatPairing = trn.seatPairing()
+	}
+	// swiss pairings are recorded as well, so that a changed pairing algorithm does not rewrite history
+	now := time.Now().UTC()
+	var pairs [][2]PlayerID
+	var teamPairs [][2]TeamID
+	if trn.Pairing == PairingSwiss && trn.PodSize == 0 {
+		if trn.TeamSize > 0 {
+			teamPairs = trn.swissTeamPairs(now)
+		} else {
+			pairs = trn.swissPairs(round, now, seatPairing)
+		}
+	}
+	first := len(trn.Matches)
+	trn.Apply(TournamentMatchesCreated{
+		ID:          uuid.MakeV4(),
+		OccurredOn:  now,
+		Tournament:  trn.ID,
+		Round:       round,
+		SeatPairing: seatPairing,
+		Pairs:       pairs,
+		TeamPairs:   teamPairs,
+	})
+	log.Printf("Event: Tournament %v: Matches created for Round %d\n", trn.ID, round)
+	if trn.Pairing == PairingDoubleElimination {
+		// top seeds advance with a bye if the number of players is not a power of 2
+		return trn.advanceDoubleElimination()
+	}
+	if trn.TeamSize > 0 {
+		// matches against dropped teams are forfeited as they are created
+		forfeited := []int{}
+		for i := first; i < len(trn.Matches); i++ {
+			if trn.Matches[i].Ended && !trn.Matches[i].Bye {
+				forfeited = append(forfeited, i)
+			}
+		}
+		return trn.concludeForfeits(forfeited)
+	}
+	if trn.PodSize > 0 {
+		return nil
+	}
+	return trn.awardByes(round)
+}
+
+// awardByes awards a bye to every player without a match in the given round
+// who has not had one yet. Round robin byes are awarded as their round starts.
+func (trn *Tournament) awardByes(round int) error {
+	for _, pID := range trn.unpairedPlayers(round) {
+		if trn.hadBye(pID) {
+			continue
+		}
+		err = trn.AwardBye(round, pID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) AwardBye(round int, pID PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.getParticipantByID(pID) == nil {
+		return fmt.Errorf("Player is not participating")
+	}
+	if trn.isDropped(pID) {
+		return fmt.Errorf("Player has dropped")
+	}
+	if trn.hadBye(pID) {
+		return fmt.Errorf("Player has already received a Bye")
+	}
+	trn.Apply(TournamentByeAwarded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Round:      round,
+		Player:     pID,
+	})
+	log.Printf("Event: Tournament %v: Round %d: Bye awarded to %v\n", trn.ID, round, pID)
+	return nil
+}
+
+func (trn *Tournament) StartRound() error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Phase != PhaseRounds {
+		return fmt.Errorf("Not in rounds phase")
+	}
+	if trn.Pairing == PairingDoubleElimination {
+		return fmt.Errorf("Brackets are not played in Rounds")
+	}
+	if trn.runningRound() != 0 {
+		return fmt.Errorf("Round %d has not ended yet", trn.runningRound())
+	}
+	round := trn.currentRound() + 1
+	if round > trn.totalRounds() {
+		return fmt.Errorf("All Rounds have been played")
+	}
+	if trn.pairsPerRound() || trn.Matches == nil {
+		err = trn.CreateMatches()
+	} else {
+		err = trn.awardByes(round)
+	}
+	if err != nil {
+		return err
+	}
+	trn.Apply(TournamentRoundStarted{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Round:      round,
+	})
+	log.Printf("Event: Tournament %v: Round %d started\n", trn.ID, round)
+	return nil
+}
+
+func (trn *Tournament) EndRound() error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	round := trn.runningRound()
+	if round == 0 {
+		return fmt.Errorf("No Round is running")
+	}
+	for _, mtc := range trn.Matches {
+		if mtc.Round == round && !mtc.Ended {
+			return fmt.Errorf("Not all Matches of Round %d have ended", round)
+		}
+	}
+	for _, pod := range trn.Pods {
+		if pod.Round == round && !pod.Ended {
+			return fmt.Errorf("Not all Pods of Round %d have ended", round)
+		}
+	}
+	trn.Apply(TournamentRoundEnded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Round:      round,
+	})
+	log.Printf("Event: Tournament %v: Round %d ended\n", trn.ID, round)
+	return nil
+}
+
+func (trn *Tournament) StartClock() error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	round := trn.runningRound()
+	if round == 0 {
+		return fmt.Errorf("No Round is running")
+	}
+	if trn.RoundTime == 0 {
+		return fmt.Errorf("No Round Time has been set")
+	}
+	if !trn.Rounds[round-1].Deadline.IsZero() {
+		return fmt.Errorf("The Clock of Round %d has already been started", round)
+	}
+	trn.Apply(TournamentRoundClockStarted{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Round:      round,
+		RoundTime:  trn.RoundTime,
+	})
+	log.Printf("Event: Tournament %v: Round %d: Clock started with %d minutes\n", trn.ID, round, trn.RoundTime)
+	return nil
+}
+
+func (trn *Tournament) CallTime() error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	round := trn.runningRound()
+	if round == 0 {
+		return fmt.Errorf("No Round is running")
+	}
+	r := trn.Rounds[round-1]
+	if r.Deadline.IsZero() {
+		return fmt.Errorf("The Clock of Round %d has not been started", round)
+	}
+	if r.TimeCalled {
+		return fmt.Errorf("Time has already been called")
+	}
+	if trn.remainingTime(time.Now().UTC()) > 0 {
+		return fmt.Errorf("Round Time is not up yet")
+	}
+	trn.Apply(TournamentTimeCalled{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Round:      round,
+	})
+	log.Printf("Event: Tournament %v: Round %d: Time called\n", trn.ID, round)
+	return nil
+}
+
+// checkMatchOpen returns an error unless match is a running match that may still end.
+func (trn *Tournament) checkMatchOpen(match int) error {
+	if match < 0 || match >= len(trn.Matches) {
+		return fmt.Errorf("Match index does not exist")
+	}
+	m := trn.Matches[match]
+	if m.Bye {
+		return fmt.Errorf("Byes cannot be concluded")
+	}
+	if m.Ended {
+		return fmt.Errorf("Match already ended")
+	}
+	if !trn.inCurrentRound(m.Round) {
+		return fmt.Errorf("Match is not part of the current Round")
+	}
+	return nil
+}
+
+// ConcludeMatch ends a match at its current score, e.g. when time has been called:
+// either as a draw or with the player who has won more games as winner.
+// Equal game counts are a draw.
+func (trn *Tournament) ConcludeMatch(match int, draw bool) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	err = trn.checkMatchOpen(match)
+	if err != nil {
+		return err
+	}
+	m := trn.Matches[match]
+	wnr := PlayerID("")
+	if !draw {
+		if m.P1Count > m.P2Count {
+			wnr = m.Player1
+		} else if m.P2Count > m.P1Count {
+			wnr = m.Player2
+		}
+	}
+	if wnr == "" && m.Bracket != "" {
+		return fmt.Errorf("Bracket Matches cannot end in a Draw")
+	}
+	return trn.EndMatch(match, wnr, wnr == "")
+}
+
+// OfferIntentionalDraw offers the opponent of pID to draw match. Once both players
+// have offered, the intentional draw is recorded.
+func (trn *Tournament) OfferIntentionalDraw(match int, pID PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	err = trn.checkMatchOpen(match)
+	if err != nil {
+		return err
+	}
+	m := trn.Matches[match]
+	if m.Bracket != "" {
+		return fmt.Errorf("Bracket Matches cannot end in a Draw")
+	}
+	side := trn.sideOf(m, pID)
+	if side == "" {
+		return fmt.Errorf("You can only offer Draws in your own Matches")
+	}
+	if m.DrawOffer == side {
+		return fmt.Errorf("A Draw has already been offered")
+	}
+	if m.DrawOffer != "" {
+		return trn.RecordIntentionalDraw(match)
+	}
+	trn.Apply(TournamentDrawOffered{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Player:     side,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Draw offered by %v\n", trn.ID, match, pID)
+	return nil
+}
+
+// RecordIntentionalDraw ends match as a draw the players have agreed on.
+func (trn *Tournament) RecordIntentionalDraw(match int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	err = trn.checkMatchOpen(match)
+	if err != nil {
+		return err
+	}
+	if trn.Matches[match].Bracket != "" {
+		return fmt.Errorf("Bracket Matches cannot end in a Draw")
+	}
+	trn.Apply(TournamentIntentionalDrawRecorded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Intentional Draw\n", trn.ID, match)
+	return trn.EndMatch(match, "", true)
+}
+
+func (trn *Tournament) AssignTable(match int, table int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if match < 0 || match >= len(trn.Matches) {
+		return fmt.Errorf("Match index does not exist")
+	}
+	m := trn.Matches[match]
+	if m.Bye {
+		return fmt.Errorf("Byes are not played at a Table")
+	}
+	if m.Ended {
+		return fmt.Errorf("Match already ended")
+	}
+	if table < 1 {
+		return fmt.Errorf("Table has to be at least 1")
+	}
+	if m.Table == table {
+		return nil
+	}
+	if trn.tableTaken(match, table) {
+		return fmt.Errorf("Table %d is already in use", table)
+	}
+	trn.Apply(TournamentTableAssigned{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Table:      table,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Assigned to Table %d\n", trn.ID, match, table)
+	return nil
+}
+
+// AssignFixedTable lets pID always play at table, e.g. for accessibility seating.
+// Table 0 removes the fixed table. Only matches created afterwards are affected.
+func (trn *Tournament) AssignFixedTable(pID PlayerID, table int) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Phase == PhaseEnded {
+		return fmt.Errorf("Tournament has already ended")
+	}
+	par := trn.getParticipantByID(pID)
+	if par == nil {
+		return fmt.Errorf("Player is not registered")
+	}
+	if table < 0 {
+		return fmt.Errorf("Table may not be negative")
+	}
+	if par.FixedTable == table {
+		return nil
+	}
+	for _, p := range trn.Participants {
+		if table > 0 && p.Player != pID && p.FixedTable == table {
+			return fmt.Errorf("Table %d is already fixed for another Player", table)
+		}
+	}
+	trn.Apply(TournamentFixedTableAssigned{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Player:     pID,
+		Table:      table,
+	})
+	log.Printf("Event: Tournament %v: Player %v: Fixed Table %d\n", trn.ID, pID, table)
+	return nil
+}
+
+func (trn *Tournament) EndGame(match int, game int, wnr PlayerID, draw bool) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	err = trn.checkGameOpen(match, game)
+	if err != nil {
+		return err
+	}
+	if wnr == "" && !draw {
+		return nil
+	}
+	if wnr != "" && draw {
+		wnr = ""
+	}
+	trn.Apply(TournamentGameEnded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Game:       game,
+		Winner:     wnr,
+		Draw:       draw,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Game %d ended... Winner: %v, Draw: %v", trn.ID, match, game, wnr, draw)
+	plrs, err := LoadPlayers(trn.Server, trn.matchPlayers(match))
+	if err != nil {
+		return err
+	}
+	for _, plr := range plrs {
+		trk, err := LoadTracker(trn.Server.es, plr.Tracker)
+		if err != nil {
+			return err
+		}
+		err = trk.IncrementGames()
+		if err != nil {
+			return err
+		}
+		if wnr != "" && containsPlayer(trn.sideMembers(trn.Matches[match], wnr), trk.Player) {
+			err = trk.IncrementGamesWon()
+			if err != nil {
+				return err
+			}
+		}
+		err = trk.Save(trn.Server.es, nil)
+		if err != nil {
+			return err
+		}
+	}
+	if trn.Matches[match].P1Count == trn.GamesToWin || trn.Matches[match].P2Count == trn.GamesToWin {
+		err = trn.EndMatch(match, wnr, draw)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkGameOpen returns an error unless the given game can receive a result.
+func (trn *Tournament) checkGameOpen(match int, game int) error {
+	if match < 0 || match >= len(trn.Matches) {
+		return fmt.Errorf("Match index does not exist")
+	}
+	if game < 0 || game >= len(trn.Matches[match].Games) {
+		return fmt.Errorf("Game index does not exist")
+	}
+	if !trn.inCurrentRound(trn.Matches[match].Round) {
+		return fmt.Errorf("Match is not part of the current Round")
+	}
+	if trn.Matches[match].Games[game].Ended {
+		return fmt.Errorf("Game has already ended")
+	}
+	return nil
+}
+
+func (trn *Tournament) ReportResult(match int, game int, reporter PlayerID, wnr PlayerID, draw bool) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	err = trn.checkGameOpen(match, game)
+	if err != nil {
+		return err
+	}
+	m := trn.Matches[match]
+	if trn.sideOf(m, reporter) == "" {
+		return fmt.Errorf("You can only report Results of your own Matches")
+	}
+	if m.Report != nil && !m.Report.Disputed {
+		return fmt.Errorf("A Result has already been reported for this Match")
+	}
+	if draw {
+		wnr = ""
+	} else if wnr == "" {
+		return nil
+	} else if wnr != m.Player1 && wnr != m.Player2 {
+		return fmt.Errorf("Winner is not part of the Match")
+	}
+	trn.Apply(TournamentResultReported{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Game:       game,
+		Reporter:   reporter,
+		Winner:     wnr,
+		Draw:       draw,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Game %d reported by %v... Winner: %v, Draw: %v\n", trn.ID, match, game, reporter, wnr, draw)
+	return nil
+}
+
+// checkOpponentOfReport returns the pending report of match if pID may answer it as an opponent.
+func (trn *Tournament) checkOpponentOfReport(match int, pID PlayerID) (*ResultReport, error) {
+	if match < 0 || match >= len(trn.Matches) {
+		return nil, fmt.Errorf("Match index does not exist")
+	}
+	m := trn.Matches[match]
+	if m.Report == nil || m.Report.Disputed {
+		return nil, fmt.Errorf("No Result pending for this Match")
+	}
+	side := trn.sideOf(m, pID)
+	if side == "" || side == trn.sideOf(m, m.Report.Reporter) {
+		return nil, fmt.Errorf("Only the Opponent can answer a reported Result")
+	}
+	return m.Report, nil
+}
+
+func (trn *Tournament) ConfirmResult(match int, pID PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	report, err := trn.checkOpponentOfReport(match, pID)
+	if err != nil {
+		return err
+	}
+	r := *report
+	trn.Apply(TournamentResultConfirmed{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Player:     pID,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Result confirmed by %v\n", trn.ID, match, pID)
+	return trn.EndGame(match, r.Game, r.Winner, r.Draw)
+}
+
+func (trn *Tournament) DisputeResult(match int, pID PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	_, err := trn.checkOpponentOfReport(match, pID)
+	if err != nil {
+		return err
+	}
+	trn.Apply(TournamentResultDisputed{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Player:     pID,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Result disputed by %v\n", trn.ID, match, pID)
+	return nil
+}
+
+func (trn *Tournament) EndMatch(match int, wnr PlayerID, draw bool) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if match >= len(trn.Matches) {
+		return fmt.Errorf("Match index does not exist")
+	}
+	if trn.Matches[match].Ended {
+		return fmt.Errorf("Match already ended")
+	}
+	trn.Apply(TournamentMatchEnded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Winner:     wnr,
+		Draw:       draw,
+	})
+	log.Printf("Event: Tournament %s: Match %d: Ended... Winner: %s, Draw: %v\n", trn.ID, match, wnr, draw)
+	err = trn.trackMatch(match)
+	if err != nil {
+		return err
+	}
+	if trn.Matches[match].Bracket != "" {
+		return trn.advanceBracket(match)
+	}
+	return nil
+}
+
+// trackMatch counts the ended match for the trackers of all its players.
+func (trn *Tournament) trackMatch(match int) error {
+	m := trn.Matches[match]
+	plrs, err := LoadPlayers(trn.Server, trn.matchPlayers(match))
+	if err != nil {
+		return err
+	}
+	for _, plr := range plrs {
+		trk, err := LoadTracker(trn.Server.es, plr.Tracker)
+		if err != nil {
+			return err
+		}
+		err = trk.IncrementMatches()
+		if err != nil {
+			return err
+		}
+		if m.Winner != "" && containsPlayer(trn.sideMembers(m, m.Winner), trk.Player) {
+			err = trk.IncrementMatchesWon()
+			if err != nil {
+				return err
+			}
+		}
+		err = trk.Save(trn.Server.es, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) RecordInfraction(match int, pID PlayerID, penalty string, reason string, judge PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if trn.Phase != PhaseRounds && trn.Phase != PhasePlayoffs {
+		return fmt.Errorf("Infractions can only be recorded during rounds and playoffs")
+	}
+	if match < 0 || match >= len(trn.Matches) {
+		return fmt.Errorf("Match index does not exist")
+	}
+	m := trn.Matches[match]
+	if m.Bye {
+		return fmt.Errorf("Infractions cannot be recorded for Byes")
+	}
+	if trn.sideOf(m, pID) == "" {
+		return fmt.Errorf("Player is not part of the Match")
+	}
+	if !containsString(penalties, penalty) {
+		return fmt.Errorf("Penalty not recognized: %s", penalty)
+	}
+	// the last game of a match is the one being played
+	game := len(m.Games) - 1
+	switch penalty {
+	case PenaltyGameLoss:
+		err = trn.checkGameOpen(match, game)
+		if err != nil {
+			return err
+		}
+	case PenaltyMatchLoss:
+		if m.Ended {
+			return fmt.Errorf("Match already ended")
+		}
+	case PenaltyDisqualification:
+		if trn.teamOf(pID) != nil {
+			return fmt.Errorf("Members of a Team cannot be disqualified individually")
+		}
+		if trn.isDropped(pID) {
+			return fmt.Errorf("Player has already dropped")
+		}
+	}
+	trn.Apply(TournamentInfractionRecorded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Player:     pID,
+		Penalty:    penalty,
+		Reason:     reason,
+		Judge:      judge,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Infraction recorded by %v... Player: %v, Penalty: %s\n", trn.ID, match, judge, pID, penalty)
+	opp := trn.opponentOf(m, pID)
+	switch penalty {
+	case PenaltyGameLoss:
+		return trn.EndGame(match, game, opp, false)
+	case PenaltyMatchLoss:
+		return trn.EndMatch(match, opp, false)
+	case PenaltyDisqualification:
+		return trn.DropPlayer(pID)
+	}
+	return nil
+}
+
+// checkCorrectableResult returns an error unless wnr or a draw can replace the result of match.
+func (trn *Tournament) checkCorrectableResult(match int, wnr PlayerID, draw bool) error {
+	if match < 0 || match >= len(trn.Matches) {
+		return fmt.Errorf("Match index does not exist")
+	}
+	m := trn.Matches[match]
+	if m.Bye {
+		return fmt.Errorf("Byes cannot be corrected")
+	}
+	if m.Bracket != "" && m.Ended {
+		return fmt.Errorf("Bracket Matches cannot be corrected once they have ended")
+	}
+	if !draw && wnr != m.Player1 && wnr != m.Player2 {
+		return fmt.Errorf("Winner is not part of the Match")
+	}
+	return nil
+}
+
+func (trn *Tournament) CorrectGame(match int, game int, wnr PlayerID, draw bool, organizer PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if draw {
+		wnr = ""
+	}
+	err = trn.checkCorrectableResult(match, wnr, draw)
+	if err != nil {
+		return err
+	}
+	if game < 0 || game >= len(trn.Matches[match].Games) {
+		return fmt.Errorf("Game index does not exist")
+	}
+	old := trn.Matches[match].Games[game]
+	if !old.Ended {
+		return fmt.Errorf("Game has not ended yet")
+	}
+	if old.Winner == wnr && old.Draw == draw {
+		return nil
+	}
+	prev := trn.Matches[match]
+	if prev.Ended && (prev.P1Count >= trn.GamesToWin || prev.P2Count >= trn.GamesToWin) {
+		// a match won by reaching GamesToWin has to stay decided by its games
+		p1, p2 := prev.P1Count, prev.P2Count
+		switch old.Winner {
+		case prev.Player1:
+			p1--
+		case prev.Player2:
+			p2--
+		}
+		switch wnr {
+		case prev.Player1:
+			p1++
+		case prev.Player2:
+			p2++
+		}
+		if p1 < trn.GamesToWin && p2 < trn.GamesToWin {
+			return fmt.Errorf("Correction would leave the Match undecided, correct the Match instead")
+		}
+	}
+	trn.Apply(TournamentGameCorrected{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		Game:       game,
+		OldWinner:  old.Winner,
+		OldDraw:    old.Draw,
+		Winner:     wnr,
+		Draw:       draw,
+		Organizer:  organizer,
+	})
+	log.Printf("Event: Tournament %v: Match %d: Game %d corrected by %v... Winner: %v, Draw: %v\n", trn.ID, match, game, organizer, wnr, draw)
+	m := trn.Matches[match]
+	if old.Winner != "" {
+		err = trn.updateTrackers(trn.sideMembers(m, old.Winner), (*Tracker).DecrementGamesWon)
+		if err != nil {
+			return err
+		}
+	}
+	if wnr != "" {
+		err = trn.updateTrackers(trn.sideMembers(m, wnr), (*Tracker).IncrementGamesWon)
+		if err != nil {
+			return err
+		}
+	}
+	if prev.Ended && prev.Winner != m.Winner {
+		// the correction changed the outcome of the match
+		if prev.Winner != "" {
+			err = trn.updateTrackers(trn.sideMembers(m, prev.Winner), (*Tracker).DecrementMatchesWon)
+			if err != nil {
+				return err
+			}
+		}
+		if m.Winner != "" {
+			err = trn.updateTrackers(trn.sideMembers(m, m.Winner), (*Tracker).IncrementMatchesWon)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if !m.Ended && (m.P1Count == trn.GamesToWin || m.P2Count == trn.GamesToWin) {
+		return trn.EndMatch(match, wnr, draw)
+	}
+	return nil
+}
+
+func (trn *Tournament) CorrectMatch(match int, wnr PlayerID, draw bool, organizer PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if draw {
+		wnr = ""
+	}
+	err = trn.checkCorrectableResult(match, wnr, draw)
+	if err != nil {
+		return err
+	}
+	old := trn.Matches[match]
+	if !old.Ended {
+		return fmt.Errorf("Match has not ended yet")
+	}
+	if old.Winner == wnr && old.Draw == draw {
+		return nil
+	}
+	trn.Apply(TournamentMatchCorrected{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Match:      match,
+		OldWinner:  old.Winner,
+		OldDraw:    old.Draw,
+		Winner:     wnr,
+		Draw:       draw,
+		Organizer:  organizer,
+	})
+	log.Printf("Event: Tournament %v: Match %d corrected by %v... Winner: %v, Draw: %v\n", trn.ID, match, organizer, wnr, draw)
+	if old.Winner != "" {
+		err = trn.updateTrackers(trn.sideMembers(old, old.Winner), (*Tracker).DecrementMatchesWon)
+		if err != nil {
+			return err
+		}
+	}
+	if wnr != "" {
+		err = trn.updateTrackers(trn.sideMembers(old, wnr), (*Tracker).IncrementMatchesWon)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateTrackers applies fn to the tracker of every given player and saves it.
+func (trn *Tournament) updateTrackers(pIDs []PlayerID, fn func(*Tracker) error) error {
+	plrs, err := LoadPlayers(trn.Server, pIDs)
+	if err != nil {
+		return err
+	}
+	for _, plr := range plrs {
+		trk, err := LoadTracker(trn.Server.es, plr.Tracker)
+		if err != nil {
+			return err
+		}
+		err = fn(trk)
+		if err != nil {
+			return err
+		}
+		err = trk.Save(trn.Server.es, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) ReportPod(pod int, placements []PlayerID) error {
+	if trn.ID == "" {
+		return fmt.Errorf("Tournament does not exist")
+	}
+	if pod < 0 || pod >= len(trn.Pods) {
+		return fmt.Errorf("Pod index does not exist")
+	}
+	if trn.Pods[pod].Ended {
+		return fmt.Errorf("Pod has already ended")
+	}
+	if trn.Pods[pod].Round != trn.runningRound() {
+		return fmt.Errorf("Pod is not part of the current Round")
+	}
+	if !isPlacementValid(trn.Pods[pod], placements) {
+		return fmt.Errorf("Placements have to list every Player of the Pod exactly once")
+	}
+	trn.Apply(TournamentPodEnded{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tournament: trn.ID,
+		Pod:        pod,
+		Placements: placements,
+	})
+	log.Printf("Event: Tournament %v: Pod %d ended... Placements: %v\n", trn.ID, pod, placements)
+	plrs, err := LoadPlayers(trn.Server, placements)
+	if err != nil {
+		return err
+	}
+	for _, plr := range plrs {
+		trk, err := LoadTracker(trn.Server.es, plr.Tracker)
+		if err != nil {
+			return err
+		}
+		err = trk.IncrementMatches()
+		if err != nil {
+			return err
+		}
+		err = trk.IncrementGames()
+		if err != nil {
+			return err
+		}
+		if trk.Player == placements[0] {
+			err = trk.IncrementMatchesWon()
+			if err != nil {
+				return err
+			}
+			err = trk.IncrementGamesWon()
+			if err != nil {
+				return err
+			}
+		}
+		err = trk.Save(trn.Server.es, nil)
 		if err != nil {
 			return err
 		}
 	}
-	log.Printf("Event: Tournament %v: Phase Changed To %v\n", trn.ID, p)
 	return nil
 }
 
-func (trn *Tournament) ChangeFormat(f string) error {
-	if trn.ID == "" {
-		return fmt.Errorf("Tournament does not exist")
-	}
-	if f == "" {
-		return fmt.Errorf("Format not specified")
-	}
-	if trn.Phase != PhaseInitialization {
-		return fmt.Errorf("Changing Format is not allowed in this Phase")
+// allMatchesEnded reports whether every match and pod has ended.
+func (trn *Tournament) allMatchesEnded() bool {
+	for _, mtc := range trn.Matches {
+		if !mtc.Ended {
+			return false
+		}
 	}
-	if trn.Format == f {
-		return nil
+	for _, pod := range trn.Pods {
+		if !pod.Ended {
+			return false
+		}
 	}
-	trn.Apply(TournamentFormatChanged{
-		ID:         uuid.MakeV4(),
-		OccurredOn: time.Now().UTC(),
-		Tournament: trn.ID,
-		Format:     f,
-	})
-	log.Printf("Event: Tournament %v: Format Changed To %s\n", trn.ID, f)
-	return nil
+	return true
 }
 
-func (trn *Tournament) ChangeMaxPlayers(n int) error {
+func (trn *Tournament) CreatePlayoffs() error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if n <= 1 {
-		return fmt.Errorf("MaxPlayers need to be at least 2")
+	if trn.Phase != PhasePlayoffs {
+		return fmt.Errorf("Not in playoffs phase")
 	}
-	trn.Apply(TournamentMaxPlayersChanged{
+	if trn.Seeds != nil {
+		return fmt.Errorf("Playoffs have already been created")
+	}
+	if len(trn.activePlayers()) < 2 {
+		return fmt.Errorf("Playoffs require at least 2 Players")
+	}
+	f, ok := formatByName(trn.Format)
+	if !ok {
+		return fmt.Errorf("Format not recognized: %s", trn.Format)
+	}
+	seeds := []PlayerID{}
+	for _, pID := range f.Standings(trn) {
+		if !trn.isDropped(pID) && len(seeds) < trn.TopCut {
+			seeds = append(seeds, pID)
+		}
+	}
+	trn.Apply(TournamentPlayoffsCreated{
 		ID:         uuid.MakeV4(),
 		OccurredOn: time.Now().UTC(),
 		Tournament: trn.ID,
-		MaxPlayers: n,
+		Seeds:      seeds,
 	})
-	log.Printf("Event: Tournament %v: MaxPlayers changed to %d\n", trn.ID, n)
+	log.Printf("Event: Tournament %v: Playoffs created for Top %d\n", trn.ID, len(seeds))
+	// top seeds advance with a bye if fewer players than the Top Cut are left
+	for i, n := 0, len(trn.Matches); i < n; i++ {
+		if m := trn.Matches[i]; m.Bracket == BracketSingle && m.Bye {
+			err = trn.advanceBracket(i)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
-func (trn *Tournament) ChangeGamesToWin(n int) error {
+func (trn *Tournament) CreateBracketMatch(bracket string, round int, slot int, p1 PlayerID, p2 PlayerID) error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if n <= 0 {
-		return fmt.Errorf("Games To Win has to be at least 1")
-	}
-	trn.Apply(TournamentGamesToWinChanged{
-		ID:         uuid.MakeV4(),
-		OccurredOn: time.Now().UTC(),
-		Tournament: trn.ID,
-		GamesToWin: n,
+	if trn.bracketMatch(bracket, round, slot) >= 0 {
+		return fmt.Errorf("Bracket Match already exists")
+	}
+	trn.Apply(TournamentBracketMatchCreated{
+		ID:           uuid.MakeV4(),
+		OccurredOn:   time.Now().UTC(),
+		Tournament:   trn.ID,
+		Bracket:      bracket,
+		BracketRound: round,
+		Slot:         slot,
+		Player1:      p1,
+		Player2:      p2,
 	})
-	log.Printf("Event: Tournament %v: GamesToWin changed to %d\n", trn.ID, n)
+	log.Printf("Event: Tournament %v: Bracket %s: Round %d: Match created... %v VS %v\n", trn.ID, bracket, round, p1, p2)
+	if match := len(trn.Matches) - 1; trn.Matches[match].Ended {
+		// a dropped player forfeits the match
+		return trn.advanceBracket(match)
+	}
 	return nil
 }
 
-func (trn *Tournament) RegisterPlayer(pID PlayerID) error {
+func (trn *Tournament) ChoosePlayDraw(match int, pID PlayerID, play bool) error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if pID == "" {
-		return fmt.Errorf("No Player specified")
-	}
-	if trn.isPlayerRegistered(pID) {
-		return fmt.Errorf("Player already registered")
-	}
-	if trn.Phase != PhaseRegistration {
-		return fmt.Errorf("Not in registration phase")
+	if match < 0 || match >= len(trn.Matches) {
+		return fmt.Errorf("Match index does not exist")
 	}
-	plr, err := LoadPlayer(trn.Server, pID)
-	if err != nil {
-		return err
+	m := trn.Matches[match]
+	if m.Bracket == "" {
+		return fmt.Errorf("Play/Draw can only be chosen in Bracket Matches")
 	}
-	err = plr.RegisterTournament(trn.ID)
-	if err != nil {
-		return err
+	if m.Player1 != pID {
+		return fmt.Errorf("Only the higher seed may choose Play/Draw")
 	}
-	err = plr.Save(trn.Server.es, nil)
-	if err != nil {
-		return err
+	if m.Ended || m.Games[0].Ended {
+		return fmt.Errorf("Match has already started")
 	}
-	trn.Apply(TournamentPlayerRegistered{
+	trn.Apply(TournamentPlayDrawChosen{
 		ID:         uuid.MakeV4(),
 		OccurredOn: time.Now().UTC(),
 		Tournament: trn.ID,
+		Match:      match,
 		Player:     pID,
+		Play:       play,
 	})
-	log.Printf("Event: Tournament %v: Player %v Registered\n", trn.ID, pID)
+	log.Printf("Event: Tournament %v: Match %d: Player %v chose Play: %v\n", trn.ID, match, pID, play)
 	return nil
 }
 
-func (trn *Tournament) DropPlayer(pID PlayerID) error {
+func (trn *Tournament) DetermineChampion(pID PlayerID) error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if pID == "" {
-		return fmt.Errorf("No Player specified")
-	}
-	if !trn.isPlayerRegistered(pID) {
-		return fmt.Errorf("Player is not registered")
+	if trn.Champion != "" {
+		return fmt.Errorf("Champion has already been determined")
 	}
-	trn.Apply(TournamentPlayerDropped{
+	trn.Apply(TournamentChampionDetermined{
 		ID:         uuid.MakeV4(),
 		OccurredOn: time.Now().UTC(),
 		Tournament: trn.ID,
 		Player:     pID,
 	})
-	log.Printf("Event: Tournament %v: Player %v Dropped\n", trn.ID, pID)
+	log.Printf("Event: Tournament %v: Champion %v\n", trn.ID, pID)
 	return nil
 }
 
-func (trn *Tournament) Begin() error {
-	if trn.ID == "" {
-		return fmt.Errorf("Tournament does not exist")
+// advanceBracket moves the winner of an ended bracket match into the next bracket round
+// as soon as the opponent is known.
+func (trn *Tournament) advanceBracket(match int) error {
+	m := trn.Matches[match]
+	if m.Bracket != BracketSingle {
+		return trn.advanceDoubleElimination()
+	}
+	if m.BracketRound == bracketRoundsFor(len(trn.Seeds)) {
+		return trn.DetermineChampion(m.Winner)
+	}
+	sibling := trn.bracketMatch(m.Bracket, m.BracketRound, m.Slot^1)
+	if sibling < 0 || !trn.Matches[sibling].Ended || trn.bracketMatch(m.Bracket, m.BracketRound+1, m.Slot/2) >= 0 {
+		return nil
 	}
-	if trn.Start != "" {
-		return fmt.Errorf("Tournament already started")
+	p1, p2 := m.Winner, trn.Matches[sibling].Winner
+	if trn.seedOf(p2) < trn.seedOf(p1) {
+		p1, p2 = p2, p1
 	}
-	trn.Apply(TournamentStarted{
-		ID:         uuid.MakeV4(),
-		OccurredOn: time.Now().UTC(),
-		Tournament: trn.ID,
-		Start:      time.Now().UTC(),
-	})
-	log.Printf("Event: Tournament %v: Started\n", trn.ID)
-	return nil
+	return trn.CreateBracketMatch(m.Bracket, m.BracketRound+1, m.Slot/2, p1, p2)
 }
 
-func (trn *Tournament) Finish() error {
+func (trn *Tournament) SubmitDeck(pID PlayerID, dID DeckID) error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if trn.Start == "" {
-		return fmt.Errorf("Tournament has not started yet")
+	if trn.Phase != PhaseDeckSubmission {
+		return fmt.Errorf("Submitting Decks is not allowed in this Phase")
 	}
-	if trn.End != "" {
-		return fmt.Errorf("Tournament has already ended")
+	if dID == "" {
+		return fmt.Errorf("Deck not specified")
 	}
-	trn.Apply(TournamentEnded{
+	par := trn.getParticipantByID(pID)
+	if par == nil {
+		return fmt.Errorf("Player is not registered")
+	}
+	if par.Deck == dID {
+		return nil
+	}
+	trn.Apply(TournamentDeckSubmitted{
 		ID:         uuid.MakeV4(),
 		OccurredOn: time.Now().UTC(),
 		Tournament: trn.ID,
-		End:        time.Now().UTC(),
+		Player:     pID,
+		Deck:       dID,
 	})
-	log.Printf("Event: Tournament %v: Ended\n", trn.ID)
+	log.Printf("Event: Tournament %v: Player %v submitted Deck %v\n", trn.ID, pID, dID)
 	return nil
 }
 
-func (trn *Tournament) CreateMatches() error {
+func (trn *Tournament) OpenPools() error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if trn.Matches != nil {
-		return fmt.Errorf("Tournament already has matches")
+	if trn.Phase != PhasePoolOpening {
+		return fmt.Errorf("Opening Pools is not allowed in this Phase")
 	}
-	trn.Apply(TournamentMatchesCreated{
+	if len(trn.CardList) == 0 || trn.PoolSize == 0 {
+		return fmt.Errorf("Card List and Pool Size have to be set")
+	}
+	for _, par := range trn.Participants {
+		if par.Pool != nil {
+			return fmt.Errorf("Pools have already been opened")
+		}
+	}
+	now := time.Now().UTC()
+	trn.Apply(TournamentPoolsOpened{
 		ID:         uuid.MakeV4(),
-		OccurredOn: time.Now().UTC(),
+		OccurredOn: now,
 		Tournament: trn.ID,
+		Seed:       now.Unix(),
 	})
-	log.Printf("Event: Tournament %v: Matches created\n", trn.ID)
+	log.Printf("Event: Tournament %v: Pools opened\n", trn.ID)
 	return nil
 }
 
-func (trn *Tournament) EndGame(match int, game int, wnr PlayerID, draw bool) error {
+func (trn *Tournament) BuildDeck(pID PlayerID, cards []string) error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if match >= len(trn.Matches) {
-		return fmt.Errorf("Match index does not exist")
-	}
-	if game >= len(trn.Matches[match].Games) {
-		return fmt.Errorf("Game index does not exist")
+	if trn.Phase != PhaseDeckSubmission {
+		return fmt.Errorf("Building Decks is not allowed in this Phase")
 	}
-	if trn.Matches[match].Games[game].Ended {
-		return fmt.Errorf("Game has already ended")
+	if len(cards) == 0 {
+		return fmt.Errorf("Deck is empty")
 	}
-	if wnr == "" && !draw {
-		return nil
+	par := trn.getParticipantByID(pID)
+	if par == nil {
+		return fmt.Errorf("Player is not registered")
 	}
-	if wnr != "" && draw {
-		wnr = ""
+	if !containsCards(par.Pool, cards) {
+		return fmt.Errorf("Deck contains Cards that are not part of the Pool")
 	}
-	trn.Apply(TournamentGameEnded{
+	trn.Apply(TournamentDeckBuilt{
 		ID:         uuid.MakeV4(),
 		OccurredOn: time.Now().UTC(),
 		Tournament: trn.ID,
-		Match:      match,
-		Game:       game,
-		Winner:     wnr,
-		Draw:       draw,
+		Player:     pID,
+		Cards:      cards,
 	})
-	log.Printf("Event: Tournament %v: Match %d: Game %d ended... Winner: %v, Draw: %v", trn.ID, match, game, wnr, draw)
-	plrs, err := LoadPlayers(trn.Server, []PlayerID{trn.Matches[match].Player1, trn.Matches[match].Player2})
-	if err != nil {
-		return err
-	}
-	for _, plr := range plrs {
-		trk, err := LoadTracker(trn.Server.es, plr.Tracker)
-		if err != nil {
-			return err
-		}
-		err = trk.IncrementGames()
-		if err != nil {
-			return err
-		}
-		if trk.Player == wnr {
-			err = trk.IncrementGamesWon()
-			if err != nil {
-				return err
-			}
-		}
-		err = trk.Save(trn.Server.es, nil)
-		if err != nil {
-			return err
-		}
-	}
-	if trn.Matches[match].P1Count == trn.GamesToWin || trn.Matches[match].P2Count == trn.GamesToWin {
-		err = trn.EndMatch(match, wnr, draw)
-		if err != nil {
-			return err
-		}
-	}
+	log.Printf("Event: Tournament %v: Player %v built a Deck of %d Cards\n", trn.ID, pID, len(cards))
 	return nil
 }
 
-func (trn *Tournament) EndMatch(match int, wnr PlayerID, draw bool) error {
+func (trn *Tournament) WaiveDecklists() error {
 	if trn.ID == "" {
 		return fmt.Errorf("Tournament does not exist")
 	}
-	if match >= len(trn.Matches) {
-		return fmt.Errorf("Match index does not exist")
+	if trn.Phase != PhaseDeckSubmission {
+		return fmt.Errorf("Waiving Decklists is not allowed in this Phase")
 	}
-	if trn.Matches[match].Ended {
-		return fmt.Errorf("Match already ended")
+	if trn.DecklistsWaived {
+		return nil
 	}
-	trn.Apply(TournamentMatchEnded{
+	trn.Apply(TournamentDecklistsWaived{
 		ID:         uuid.MakeV4(),
 		OccurredOn: time.Now().UTC(),
 		Tournament: trn.ID,
-		Match:      match,
-		Winner:     wnr,
-		Draw:       draw,
 	})
-	log.Printf("Event: Tournament %s: Match %d: Ended... Winner: %s, Draw: %v\n", trn.ID, match, wnr, draw)
-	plrs, err := LoadPlayers(trn.Server, []PlayerID{trn.Matches[match].Player1, trn.Matches[match].Player2})
-	if err != nil {
-		return err
-	}
-	for _, plr := range plrs {
-		trk, err := LoadTracker(trn.Server.es, plr.Tracker)
-		if err != nil {
-			return err
-		}
-		err = trk.IncrementMatches()
-		if err != nil {
-			return err
-		}
-		if trk.Player == wnr {
-			err = trk.IncrementMatchesWon()
-			if err != nil {
-				return err
-			}
-		}
-		err = trk.Save(trn.Server.es, nil)
-		if err != nil {
-			return err
-		}
-	}
-
+	log.Printf("Event: Tournament %v: Decklists waived\n", trn.ID)
 	return nil
 }
 
@@ -499,30 +2517,229 @@ func (trn *Tournament) Mutate(e event.Event) {
 		trn.Phase = e.Phase
 	case TournamentFormatChanged:
 		trn.Format = e.Format
+	case TournamentDeckSubmitted:
+		if par := trn.getParticipantByID(e.Player); par != nil {
+			par.Deck = e.Deck
+		}
+	case TournamentDecklistsWaived:
+		trn.DecklistsWaived = true
+	case TournamentPodSizeChanged:
+		trn.PodSize = e.PodSize
+	case TournamentPlacementPointsChanged:
+		trn.PlacementPoints = e.Points
+	case TournamentPodEnded:
+		trn.Pods[e.Pod].Placements = e.Placements
+		trn.Pods[e.Pod].Ended = true
+		trn.managePodResult(e.Pod)
+	case TournamentTeamSizeChanged:
+		trn.TeamSize = e.TeamSize
+	case TournamentTeamRegistered:
+		trn.Teams = append(trn.Teams, TeamEntry{ID: e.Team, Name: e.Name, Members: e.Members})
+		for _, pID := range e.Members {
+			trn.Participants = append(trn.Participants, Participant{Player: pID})
+		}
+	case TournamentTeamWaitlisted:
+		trn.TeamWaitlist = append(trn.TeamWaitlist, TeamEntry{ID: e.Team, Name: e.Name, Members: e.Members})
+	case TournamentTeamWaitlistPromoted:
+		if t := trn.getWaitlistedTeam(e.Team); t != nil {
+			trn.Teams = append(trn.Teams, *t)
+			for _, pID := range t.Members {
+				trn.Participants = append(trn.Participants, Participant{Player: pID})
+			}
+			trn.removeFromTeamWaitlist(e.Team)
+		}
+	case TournamentTeamDropped:
+		if trn.getWaitlistedTeam(e.Team) != nil {
+			trn.removeFromTeamWaitlist(e.Team)
+			break
+		}
+		if trn.Phase != PhaseRegistration && trn.Phase != PhaseCheckIn {
+			trn.manageTeamDrop(e.Team)
+			break
+		}
+		for i, t := range trn.Teams {
+			if t.ID == e.Team {
+				for _, pID := range t.Members {
+					trn.removePlayer(pID)
+				}
+				trn.Teams = append(trn.Teams[:i], trn.Teams[i+1:]...)
+				break
+			}
+		}
+	case TournamentCardListChanged:
+		trn.CardList = e.Cards
+	case TournamentPoolSizeChanged:
+		trn.PoolSize = e.PoolSize
+	case TournamentPoolsOpened:
+		trn.dealPools(e.Seed)
+	case TournamentDeckBuilt:
+		if par := trn.getParticipantByID(e.Player); par != nil {
+			par.DeckCards = e.Cards
+		}
 	case TournamentMaxPlayersChanged:
 		trn.MaxPlayers = e.MaxPlayers
+	case TournamentPairingChanged:
+		trn.Pairing = e.Pairing
+	case TournamentSeatPairingChanged:
+		trn.SeatPairing = e.SeatPairing
+	case TournamentPointsSystemChanged:
+		ps := e.PointsSystem
+		trn.PointsSystem = &ps
+	case TournamentTiebreakersChanged:
+		trn.Tiebreakers = e.Tiebreakers
+	case TournamentRoundTimeChanged:
+		trn.RoundTime = e.RoundTime
+	case TournamentScheduleChanged:
+		trn.Schedule = e.Schedule
+	case TournamentNumberOfRoundsChanged:
+		trn.NumberOfRounds = e.NumberOfRounds
+	case TournamentTopCutChanged:
+		trn.TopCut = e.TopCut
 	case TournamentPlayerRegistered:
 		trn.Participants = append(trn.Participants, Participant{Player: e.Player})
+	case TournamentPlayerWaitlisted:
+		trn.Waitlist = append(trn.Waitlist, e.Player)
+	case TournamentWaitlistPromoted:
+		trn.removeFromWaitlist(e.Player)
+		trn.Participants = append(trn.Participants, Participant{Player: e.Player})
 	case TournamentPlayerDropped:
-		trn.removePlayer(e.Player)
+		if trn.isWaitlisted(e.Player) {
+			trn.removeFromWaitlist(e.Player)
+		} else if trn.Phase == PhaseRegistration || trn.Phase == PhaseCheckIn {
+			trn.removePlayer(e.Player)
+		} else {
+			trn.manageDrop(e.Player)
+		}
+	case TournamentPlayerCheckedIn:
+		if par := trn.getParticipantByID(e.Player); par != nil {
+			par.CheckedIn = true
+		}
 	case TournamentStarted:
 		trn.Start = e.Start.String()
 	case TournamentEnded:
 		trn.End = e.End.String()
 	case TournamentMatchesCreated:
-		trn.MakeMatches()
+		switch {
+		case trn.PodSize > 0:
+			trn.MakePods(e.Round, e.OccurredOn)
+		case trn.TeamSize > 0:
+			trn.MakeTeamMatches(e.Round, e.OccurredOn, e.TeamPairs)
+		case trn.Pairing == PairingSwiss:
+			trn.MakeSwissMatches(e.Round, e.OccurredOn, e.SeatPairing, e.Pairs)
+		case trn.Pairing == PairingDoubleElimination:
+			trn.MakeDoubleEliminationMatches()
+		default:
+			trn.makeMatches(e.SeatPairing)
+		}
+		trn.assignTables()
+	case TournamentByeAwarded:
+		trn.manageBye(e.Round, e.Player)
+	case TournamentGameCorrected:
+		m := &trn.Matches[e.Match]
+		wnr, draw := gamesOutcome(*m)
+		// an ended match that was decided by its games follows the corrected games,
+		// results decided otherwise, e.g. by a penalty, are kept
+		decided := m.Ended && m.Winner == wnr && m.Draw == draw
+		g := &m.Games[e.Game]
+		g.Winner = e.Winner
+		g.Draw = e.Draw
+		trn.recountGameWins(e.Match)
+		if decided {
+			m.Winner, m.Draw = gamesOutcome(*m)
+		}
+		trn.recountParticipants()
+	case TournamentMatchCorrected:
+		m := &trn.Matches[e.Match]
+		m.Winner = e.Winner
+		m.Draw = e.Draw
+		trn.recountParticipants()
+	case TournamentResultReported:
+		trn.Matches[e.Match].Report = &ResultReport{
+			Game:     e.Game,
+			Reporter: e.Reporter,
+			Winner:   e.Winner,
+			Draw:     e.Draw,
+		}
+	case TournamentResultConfirmed:
+		trn.Matches[e.Match].Report = nil
+	case TournamentResultDisputed:
+		trn.Matches[e.Match].Report.Disputed = true
 	case TournamentGameEnded:
 		g := &trn.Matches[e.Match].Games[e.Game]
 		g.Winner = e.Winner
 		g.Draw = e.Draw
 		g.Ended = true
+		trn.Matches[e.Match].Report = nil
 		trn.manageGameWins(e.Match, e.Game)
+	case TournamentRoundStarted:
+		trn.Rounds = append(trn.Rounds, Round{Number: e.Round, Start: e.OccurredOn})
+	case TournamentRoundClockStarted:
+		trn.Rounds[e.Round-1].Deadline = e.OccurredOn.Add(time.Duration(e.RoundTime) * time.Minute)
+	case TournamentTimeCalled:
+		trn.manageTimeCalled(e.Round)
+	case TournamentTableAssigned:
+		trn.Matches[e.Match].Table = e.Table
+	case TournamentFixedTableAssigned:
+		trn.getParticipantByID(e.Player).FixedTable = e.Table
+	case TournamentDrawOffered:
+		trn.Matches[e.Match].DrawOffer = e.Player
+	case TournamentIntentionalDrawRecorded:
+		trn.Matches[e.Match].IntentionalDraw = true
+	case TournamentRoundEnded:
+		r := &trn.Rounds[e.Round-1]
+		r.End = e.OccurredOn
+		r.Ended = true
 	case TournamentMatchEnded:
 		m := &trn.Matches[e.Match]
 		m.Winner = e.Winner
 		m.Draw = e.Draw
 		m.Ended = true
+		m.AdditionalTurns = false
+		m.DrawOffer = ""
 		trn.manageMatchWin(e.Match)
+	case TournamentInfractionRecorded:
+		trn.Infractions = append(trn.Infractions, Infraction{
+			Match:      e.Match,
+			Player:     e.Player,
+			Penalty:    e.Penalty,
+			Reason:     e.Reason,
+			Judge:      e.Judge,
+			OccurredOn: e.OccurredOn,
+		})
+	case TournamentPlayoffsCreated:
+		trn.Seeds = e.Seeds
+		trn.MakePlayoffMatches()
+		trn.assignTables()
+	case TournamentBracketMatchCreated:
+		trn.Matches = append(trn.Matches, Match{
+			Player1:      e.Player1,
+			Player2:      e.Player2,
+			Bracket:      e.Bracket,
+			BracketRound: e.BracketRound,
+			Slot:         e.Slot,
+			Playoff:      trn.Phase == PhasePlayoffs,
+			Games:        []Game{{}},
+		})
+		if m := &trn.Matches[len(trn.Matches)-1]; m.Player2 == "" {
+			// a missing player leaves a bye, or an empty position if both are missing
+			m.Winner = m.Player1
+			m.Bye = m.Player1 != ""
+			m.Games = nil
+			m.Ended = true
+		}
+		trn.forfeitMatch(len(trn.Matches) - 1)
+		trn.assignTables()
+	case TournamentPlayDrawChosen:
+		m := &trn.Matches[e.Match]
+		if e.Play {
+			m.OnThePlay = e.Player
+		} else if m.Player1 == e.Player {
+			m.OnThePlay = m.Player2
+		} else {
+			m.OnThePlay = m.Player1
+		}
+	case TournamentChampionDetermined:
+		trn.Champion = e.Player
 	}
 }
 
@@ -580,6 +2797,56 @@ func (trn *Tournament) isPlayerRegistered(pID PlayerID) bool {
 	return false
 }
 
+// isFull reports whether MaxPlayers participants have registered.
+func (trn *Tournament) isFull() bool {
+	return !trn.hasRoomFor(1)
+}
+
+// hasRoomFor reports whether n more players fit into the tournament.
+func (trn *Tournament) hasRoomFor(n int) bool {
+	return trn.MaxPlayers == 0 || len(trn.Participants)+n <= trn.MaxPlayers
+}
+
+func (trn *Tournament) isWaitlisted(pID PlayerID) bool {
+	return containsPlayer(trn.Waitlist, pID)
+}
+
+func (trn *Tournament) removeFromWaitlist(pID PlayerID) {
+	for i, p := range trn.Waitlist {
+		if p == pID {
+			trn.Waitlist = append(trn.Waitlist[:i], trn.Waitlist[i+1:]...)
+			return
+		}
+	}
+}
+
+func (trn *Tournament) getWaitlistedTeam(tID TeamID) *TeamEntry {
+	for i := range trn.TeamWaitlist {
+		if trn.TeamWaitlist[i].ID == tID {
+			return &trn.TeamWaitlist[i]
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) waitlistedTeamOf(pID PlayerID) *TeamEntry {
+	for i := range trn.TeamWaitlist {
+		if containsPlayer(trn.TeamWaitlist[i].Members, pID) {
+			return &trn.TeamWaitlist[i]
+		}
+	}
+	return nil
+}
+
+func (trn *Tournament) removeFromTeamWaitlist(tID TeamID) {
+	for i, t := range trn.TeamWaitlist {
+		if t.ID == tID {
+			trn.TeamWaitlist = append(trn.TeamWaitlist[:i], trn.TeamWaitlist[i+1:]...)
+			return
+		}
+	}
+}
+
 func (trn *Tournament) removePlayer(pID PlayerID) {
 	for i, v := range trn.Participants {
 		if v.Player == pID {
@@ -40,6 +40,18 @@ type TrackerGameWon struct {
 	Tracker    TrackerID `json:"tracker"`
 }
 
+type TrackerMatchWinRevoked struct {
+	ID         string    `json:"id"`
+	OccurredOn time.Time `json:"occurred-on"`
+	Tracker    TrackerID `json:"tracker"`
+}
+
+type TrackerGameWinRevoked struct {
+	ID         string    `json:"id"`
+	OccurredOn time.Time `json:"occurred-on"`
+	Tracker    TrackerID `json:"tracker"`
+}
+
 func NewTracker() *Tracker {
 	return &Tracker{
 		ChangeRecorder: event.NewChangeRecorder(),
@@ -118,6 +130,38 @@ func (trk *Tracker) IncrementGamesWon() error {
 	return nil
 }
 
+func (trk *Tracker) DecrementMatchesWon() error {
+	if trk.ID == "" {
+		return fmt.Errorf("Tracker does not exist")
+	}
+	if trk.MatchWins == 0 {
+		return fmt.Errorf("Tracker has no Match Wins to revoke")
+	}
+	trk.Apply(TrackerMatchWinRevoked{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tracker:    trk.ID,
+	})
+	log.Printf("Event: Tracker %s: MatchWins for Player %s decremented", trk.ID, trk.Player)
+	return nil
+}
+
+func (trk *Tracker) DecrementGamesWon() error {
+	if trk.ID == "" {
+		return fmt.Errorf("Tracker does not exist")
+	}
+	if trk.GameWins == 0 {
+		return fmt.Errorf("Tracker has no Game Wins to revoke")
+	}
+	trk.Apply(TrackerGameWinRevoked{
+		ID:         uuid.MakeV4(),
+		OccurredOn: time.Now().UTC(),
+		Tracker:    trk.ID,
+	})
+	log.Printf("Event: Tracker %s: GameWins for Player %s decremented", trk.ID, trk.Player)
+	return nil
+}
+
 func (trk *Tracker) Mutate(e event.Event) {
 	trk.Version++
 	switch e := e.(type) {
@@ -132,6 +176,10 @@ func (trk *Tracker) Mutate(e event.Event) {
 		trk.Matches++
 	case TrackerMatchWon:
 		trk.MatchWins++
+	case TrackerMatchWinRevoked:
+		trk.MatchWins--
+	case TrackerGameWinRevoked:
+		trk.GameWins--
 	}
 }
 